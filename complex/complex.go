@@ -0,0 +1,233 @@
+// # Complex
+//
+// This package contains a complex number data structure, built on top of
+// go's complex128, with arithmetic, elementary functions, parsing and formatting.
+package complex
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidFormat = errors.New("invalid complex number format")
+)
+
+// Complex represents a complex number with real and imaginary parts.
+//
+// # Note
+//
+// The type is a go complex128, so values can be freely converted
+// to and from the builtin type.
+type Complex complex128
+
+// NewComplex instantiates a new complex number given its real and
+// imaginary parts.
+func NewComplex(re, im float64) Complex {
+	return Complex(complex(re, im))
+}
+
+// NewComplexFromPolar instantiates a new complex number given its
+// magnitude and phase, in radians.
+func NewComplexFromPolar(magnitude, phase float64) Complex {
+	return Complex(cmplx.Rect(magnitude, phase))
+}
+
+// Parse converts a string like "3+4i", "-2.5", "4i" or "-i" into a
+// complex number. Spaces and enclosing parentheses are accepted.
+func Parse(s string) (Complex, error) {
+	str := strings.ReplaceAll(s, " ", "")
+	str = strings.TrimSuffix(strings.TrimPrefix(str, "("), ")")
+
+	// a lonely imaginary unit has an implicit coefficient of one, unless
+	// the sign belongs to an exponent like in "1e-i", which is invalid
+	if strings.HasSuffix(str, "i") {
+		n := len(str)
+		if n == 1 || isSign(str[n-2]) && (n == 2 || !isExponent(str[n-3])) {
+			str = str[:n-1] + "1i"
+		}
+	}
+
+	c, err := strconv.ParseComplex(str, 128)
+	if err != nil {
+		return 0, ErrInvalidFormat
+	}
+	return Complex(c), nil
+}
+
+// isSign checks if the character is a plus or minus sign.
+func isSign(c byte) bool {
+	return c == '+' || c == '-'
+}
+
+// isExponent checks if the character is an exponent marker.
+func isExponent(c byte) bool {
+	return c == 'e' || c == 'E'
+}
+
+// Re returns the real part.
+func (c Complex) Re() float64 {
+	return real(c)
+}
+
+// Im returns the imaginary part.
+func (c Complex) Im() float64 {
+	return imag(c)
+}
+
+// Magnitude returns the magnitude (absolute value) of the complex number.
+func (c Complex) Magnitude() float64 {
+	return cmplx.Abs(complex128(c))
+}
+
+// SquaredMagnitude returns the squared magnitude of the complex number.
+func (c Complex) SquaredMagnitude() float64 {
+	return real(c)*real(c) + imag(c)*imag(c)
+}
+
+// Phase returns the phase (argument) of the complex number, in radians,
+// in the interval [-Pi, Pi].
+func (c Complex) Phase() float64 {
+	return cmplx.Phase(complex128(c))
+}
+
+// Sum implements complex addition.
+func (c Complex) Sum(a Complex) Complex {
+	return c + a
+}
+
+// SumScalar adds a real scalar.
+func (c Complex) SumScalar(scalar float64) Complex {
+	return NewComplex(real(c)+scalar, imag(c))
+}
+
+// Subtract implements complex subtraction.
+func (c Complex) Subtract(a Complex) Complex {
+	return c - a
+}
+
+// SubScalar subtracts a real scalar.
+func (c Complex) SubScalar(scalar float64) Complex {
+	return NewComplex(real(c)-scalar, imag(c))
+}
+
+// Multiply implements complex multiplication.
+func (c Complex) Multiply(a Complex) Complex {
+	return c * a
+}
+
+// MulScalar implements multiplication by a real scalar.
+func (c Complex) MulScalar(scalar float64) Complex {
+	return NewComplex(real(c)*scalar, imag(c)*scalar)
+}
+
+// Divide implements complex division.
+func (c Complex) Divide(a Complex) Complex {
+	if a == 0 {
+		panic("cant divide by zero")
+	}
+	return c / a
+}
+
+// DivScalar implements division by a real scalar.
+func (c Complex) DivScalar(scalar float64) Complex {
+	if scalar == 0.0 {
+		panic("cant divide by zero")
+	}
+	return NewComplex(real(c)/scalar, imag(c)/scalar)
+}
+
+// Negate returns the additive inverse of the complex number.
+func (c Complex) Negate() Complex {
+	return -c
+}
+
+// Conjugate returns the complex conjugate.
+func (c Complex) Conjugate() Complex {
+	return Complex(cmplx.Conj(complex128(c)))
+}
+
+// Reciprocal returns 1/c, or zero if the complex number is also zero.
+func (c Complex) Reciprocal() Complex {
+	if c == 0 {
+		return 0
+	}
+	return 1 / c
+}
+
+// Sqrt returns the principal square root.
+func (c Complex) Sqrt() Complex {
+	return Complex(cmplx.Sqrt(complex128(c)))
+}
+
+// Log returns the principal natural logarithm.
+func (c Complex) Log() Complex {
+	return Complex(cmplx.Log(complex128(c)))
+}
+
+// Exp returns e raised to the complex number.
+func (c Complex) Exp() Complex {
+	return Complex(cmplx.Exp(complex128(c)))
+}
+
+// Pow returns c raised to the power a.
+func (c Complex) Pow(a Complex) Complex {
+	return Complex(cmplx.Pow(complex128(c), complex128(a)))
+}
+
+// Sin returns the complex sine.
+func (c Complex) Sin() Complex {
+	return Complex(cmplx.Sin(complex128(c)))
+}
+
+// Cos returns the complex cosine.
+func (c Complex) Cos() Complex {
+	return Complex(cmplx.Cos(complex128(c)))
+}
+
+// Tan returns the complex tangent.
+func (c Complex) Tan() Complex {
+	return Complex(cmplx.Tan(complex128(c)))
+}
+
+// Sinh returns the complex hyperbolic sine.
+func (c Complex) Sinh() Complex {
+	return Complex(cmplx.Sinh(complex128(c)))
+}
+
+// Cosh returns the complex hyperbolic cosine.
+func (c Complex) Cosh() Complex {
+	return Complex(cmplx.Cosh(complex128(c)))
+}
+
+// Tanh returns the complex hyperbolic tangent.
+func (c Complex) Tanh() Complex {
+	return Complex(cmplx.Tanh(complex128(c)))
+}
+
+// Equals compares both parts of the complex numbers using epsilon.
+func (c Complex) Equals(a Complex, epsilon float64) bool {
+	dre := math.Abs(real(c)-real(a)) < epsilon
+	dim := math.Abs(imag(c)-imag(a)) < epsilon
+	return dre && dim
+}
+
+// String formats the complex number like "3+4i" or "3-4i".
+func (c Complex) String() string {
+	re := strconv.FormatFloat(real(c), 'g', -1, 64)
+	im := strconv.FormatFloat(imag(c), 'g', -1, 64)
+	// FormatFloat already signs negative numbers and +Inf
+	if !isSign(im[0]) {
+		im = "+" + im
+	}
+	return re + im + "i"
+}
+
+// Delta is used for compare complex numbers. The epsilon constant
+// used is 0.0001 decimal precision, as in cmath.Delta.
+func Delta(a, b Complex) bool {
+	return a.Equals(b, 0.0001)
+}
@@ -0,0 +1,143 @@
+package complex
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplex(t *testing.T) {
+	c1 := NewComplex(3, 4)
+	c2 := NewComplex(1, -2)
+
+	t.Run("test magnitude and phase", func(t *testing.T) {
+		assert.Equal(t, 3.0, c1.Re())
+		assert.Equal(t, 4.0, c1.Im())
+		assert.InDelta(t, 5.0, c1.Magnitude(), 0.0001)
+		assert.InDelta(t, 25.0, c1.SquaredMagnitude(), 0.0001)
+		assert.InDelta(t, 0.927, c1.Phase(), 0.001)
+	})
+
+	t.Run("test polar constructor", func(t *testing.T) {
+		result := NewComplexFromPolar(2, math.Pi/2)
+		assert.True(t, Delta(NewComplex(0, 2), result))
+
+		result = NewComplexFromPolar(c1.Magnitude(), c1.Phase())
+		assert.True(t, Delta(c1, result))
+	})
+
+	t.Run("test arithmetic", func(t *testing.T) {
+		assert.Equal(t, NewComplex(4, 2), c1.Sum(c2))
+		assert.Equal(t, NewComplex(2, 6), c1.Subtract(c2))
+		assert.Equal(t, NewComplex(11, -2), c1.Multiply(c2))
+		assert.True(t, Delta(NewComplex(-1, 2), c1.Divide(c2)))
+		assert.Equal(t, NewComplex(5, 4), c1.SumScalar(2))
+		assert.Equal(t, NewComplex(1, 4), c1.SubScalar(2))
+		assert.Equal(t, NewComplex(6, 8), c1.MulScalar(2))
+		assert.Equal(t, NewComplex(1.5, 2), c1.DivScalar(2))
+		assert.Equal(t, NewComplex(-3, -4), c1.Negate())
+		assert.Equal(t, NewComplex(3, -4), c1.Conjugate())
+		assert.True(t, Delta(NewComplex(0.12, -0.16), c1.Reciprocal()))
+		assert.Equal(t, NewComplex(0, 0), NewComplex(0, 0).Reciprocal())
+
+		// fail dividing by zero
+		assert.Panics(t, func() {
+			_ = c1.Divide(0)
+		})
+		assert.Panics(t, func() {
+			_ = c1.DivScalar(0)
+		})
+	})
+
+	t.Run("test elementary functions", func(t *testing.T) {
+		assert.True(t, Delta(NewComplex(2, 1), c1.Sqrt()))
+		assert.True(t, Delta(NewComplex(0, 1), NewComplex(-1, 0).Sqrt()))
+		assert.True(t, Delta(c1, c1.Log().Exp()))
+		assert.True(t, Delta(NewComplex(-1, 0), NewComplex(0, math.Pi).Exp()))
+		assert.True(t, Delta(c1.Multiply(c1), c1.Pow(2)))
+		assert.True(t, Delta(NewComplex(0, 0), NewComplex(0, 0).Pow(2)))
+	})
+
+	t.Run("test trigonometric and hyperbolic functions", func(t *testing.T) {
+		z := NewComplex(0.5, -0.3)
+		one := NewComplex(1, 0)
+		sin, cos := z.Sin(), z.Cos()
+		assert.True(t, Delta(one, sin.Multiply(sin).Sum(cos.Multiply(cos))))
+		assert.True(t, Delta(z.Tan(), sin.Divide(cos)))
+
+		sinh, cosh := z.Sinh(), z.Cosh()
+		assert.True(t, Delta(one, cosh.Multiply(cosh).Subtract(sinh.Multiply(sinh))))
+		assert.True(t, Delta(z.Tanh(), sinh.Divide(cosh)))
+
+		// sin(ix) = i sinh(x)
+		assert.True(t, Delta(NewComplex(0, math.Sinh(1)), NewComplex(0, 1).Sin()))
+	})
+
+	t.Run("test equals", func(t *testing.T) {
+		assert.True(t, c1.Equals(NewComplex(3.0001, 3.9999), 0.001))
+		assert.False(t, c1.Equals(c2, 0.001))
+	})
+
+	t.Run("test string formatting", func(t *testing.T) {
+		assert.Equal(t, "3+4i", c1.String())
+		assert.Equal(t, "1-2i", c2.String())
+		assert.Equal(t, "-0.5+0i", NewComplex(-0.5, 0).String())
+	})
+
+	t.Run("test parsing", func(t *testing.T) {
+		testCases := []struct {
+			input    string
+			expected Complex
+		}{
+			{"3+4i", NewComplex(3, 4)},
+			{"1-2i", NewComplex(1, -2)},
+			{" 3 + 4i ", NewComplex(3, 4)},
+			{"(3+4i)", NewComplex(3, 4)},
+			{"-2.5", NewComplex(-2.5, 0)},
+			{"4i", NewComplex(0, 4)},
+			{"i", NewComplex(0, 1)},
+			{"-i", NewComplex(0, -1)},
+			{"2+i", NewComplex(2, 1)},
+			{"1e-3-2e2i", NewComplex(0.001, -200)},
+		}
+		for _, tc := range testCases {
+			result, err := Parse(tc.input)
+			assert.Nil(t, err, tc.input)
+			assert.Equal(t, tc.expected, result, tc.input)
+		}
+
+		for _, input := range []string{"", "3+4j", "abc", "3+4i+1", "1e-i", "2e+i"} {
+			_, err := Parse(input)
+			assert.ErrorIs(t, err, ErrInvalidFormat, input)
+		}
+
+		result, err := Parse(c2.String())
+		assert.Nil(t, err)
+		assert.Equal(t, c2, result)
+	})
+
+	t.Run("string and parse round trip of special values", func(t *testing.T) {
+		inf, nan := math.Inf(1), math.NaN()
+		testCases := []struct {
+			value    Complex
+			expected string
+		}{
+			{NewComplex(1, inf), "1+Infi"},
+			{NewComplex(1, -inf), "1-Infi"},
+			{NewComplex(-inf, 2), "-Inf+2i"},
+		}
+		for _, tc := range testCases {
+			assert.Equal(t, tc.expected, tc.value.String())
+			result, err := Parse(tc.value.String())
+			assert.Nil(t, err, tc.expected)
+			assert.Equal(t, tc.value, result, tc.expected)
+		}
+
+		c := NewComplex(nan, nan)
+		assert.Equal(t, "NaN+NaNi", c.String())
+		result, err := Parse(c.String())
+		assert.Nil(t, err)
+		assert.True(t, math.IsNaN(result.Re()) && math.IsNaN(result.Im()))
+	})
+}
@@ -0,0 +1,536 @@
+package distributions
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Normal is the gaussian distribution with the given mean and standard deviation.
+type Normal struct {
+	mean   float64
+	stdDev float64
+}
+
+// NewNormal instantiates a normal distribution. The standard deviation
+// must be a positive number.
+func NewNormal(mean, stdDev float64) (Normal, error) {
+	if math.IsNaN(mean) || math.IsInf(mean, 0) || !validPositive(stdDev) {
+		return Normal{}, ErrBadParameter
+	}
+	return Normal{mean: mean, stdDev: stdDev}, nil
+}
+
+// PDF evaluates the probability density function at x.
+func (n Normal) PDF(x float64) float64 {
+	z := (x - n.mean) / n.stdDev
+	return math.Exp(-0.5*z*z) / (n.stdDev * math.Sqrt(2.0*math.Pi))
+}
+
+// CDF evaluates the cumulative distribution function at x.
+func (n Normal) CDF(x float64) float64 {
+	return 0.5 * math.Erfc(-(x-n.mean)/(n.stdDev*math.Sqrt2))
+}
+
+// Survival evaluates the survival function 1 - CDF(x), without losing
+// precision in the upper tail.
+func (n Normal) Survival(x float64) float64 {
+	return 0.5 * math.Erfc((x-n.mean)/(n.stdDev*math.Sqrt2))
+}
+
+// Quantile evaluates the inverse of the cumulative distribution function.
+func (n Normal) Quantile(p float64) (float64, error) {
+	if !validProbability(p) {
+		return 0.0, ErrBadProbability
+	}
+	return n.mean + n.stdDev*math.Sqrt2*math.Erfinv(2.0*p-1.0), nil
+}
+
+// Mean returns the distribution's expected value.
+func (n Normal) Mean() float64 {
+	return n.mean
+}
+
+// Variance returns the distribution's variance.
+func (n Normal) Variance() float64 {
+	return n.stdDev * n.stdDev
+}
+
+// StdDev returns the distribution's standard deviation.
+func (n Normal) StdDev() float64 {
+	return n.stdDev
+}
+
+// Sample draws a random value from the distribution.
+func (n Normal) Sample(r *rand.Rand) float64 {
+	return n.mean + n.stdDev*r.NormFloat64()
+}
+
+// Uniform is the continuous uniform distribution over the interval [min, max].
+type Uniform struct {
+	min float64
+	max float64
+}
+
+// NewUniform instantiates a uniform distribution. The bounds must be
+// finite and min must be lesser than max.
+func NewUniform(min, max float64) (Uniform, error) {
+	if math.IsInf(min, 0) || math.IsInf(max, 0) || !(min < max) {
+		return Uniform{}, ErrBadParameter
+	}
+	return Uniform{min: min, max: max}, nil
+}
+
+// PDF evaluates the probability density function at x.
+func (u Uniform) PDF(x float64) float64 {
+	if x < u.min || x > u.max {
+		return 0.0
+	}
+	return 1.0 / (u.max - u.min)
+}
+
+// CDF evaluates the cumulative distribution function at x.
+func (u Uniform) CDF(x float64) float64 {
+	switch {
+	case x <= u.min:
+		return 0.0
+	case x >= u.max:
+		return 1.0
+	default:
+		return (x - u.min) / (u.max - u.min)
+	}
+}
+
+// Survival evaluates the survival function 1 - CDF(x).
+func (u Uniform) Survival(x float64) float64 {
+	return 1.0 - u.CDF(x)
+}
+
+// Quantile evaluates the inverse of the cumulative distribution function.
+func (u Uniform) Quantile(p float64) (float64, error) {
+	if !validProbability(p) {
+		return 0.0, ErrBadProbability
+	}
+	return u.min + p*(u.max-u.min), nil
+}
+
+// Mean returns the distribution's expected value.
+func (u Uniform) Mean() float64 {
+	return (u.min + u.max) / 2.0
+}
+
+// Variance returns the distribution's variance.
+func (u Uniform) Variance() float64 {
+	d := u.max - u.min
+	return d * d / 12.0
+}
+
+// Sample draws a random value from the distribution.
+func (u Uniform) Sample(r *rand.Rand) float64 {
+	return u.min + r.Float64()*(u.max-u.min)
+}
+
+// Exponential is the exponential distribution with the given rate.
+type Exponential struct {
+	rate float64
+}
+
+// NewExponential instantiates an exponential distribution. The rate
+// must be a positive number.
+func NewExponential(rate float64) (Exponential, error) {
+	if !validPositive(rate) {
+		return Exponential{}, ErrBadParameter
+	}
+	return Exponential{rate: rate}, nil
+}
+
+// PDF evaluates the probability density function at x.
+func (e Exponential) PDF(x float64) float64 {
+	if x < 0.0 {
+		return 0.0
+	}
+	return e.rate * math.Exp(-e.rate*x)
+}
+
+// CDF evaluates the cumulative distribution function at x.
+func (e Exponential) CDF(x float64) float64 {
+	if x <= 0.0 {
+		return 0.0
+	}
+	return -math.Expm1(-e.rate * x)
+}
+
+// Survival evaluates the survival function 1 - CDF(x), without losing
+// precision in the upper tail.
+func (e Exponential) Survival(x float64) float64 {
+	if x <= 0.0 {
+		return 1.0
+	}
+	return math.Exp(-e.rate * x)
+}
+
+// Quantile evaluates the inverse of the cumulative distribution function.
+func (e Exponential) Quantile(p float64) (float64, error) {
+	if !validProbability(p) {
+		return 0.0, ErrBadProbability
+	}
+	return -math.Log1p(-p) / e.rate, nil
+}
+
+// Mean returns the distribution's expected value.
+func (e Exponential) Mean() float64 {
+	return 1.0 / e.rate
+}
+
+// Variance returns the distribution's variance.
+func (e Exponential) Variance() float64 {
+	return 1.0 / (e.rate * e.rate)
+}
+
+// Sample draws a random value from the distribution.
+func (e Exponential) Sample(r *rand.Rand) float64 {
+	return r.ExpFloat64() / e.rate
+}
+
+// Gamma is the gamma distribution with the given shape and scale.
+type Gamma struct {
+	shape float64
+	scale float64
+}
+
+// NewGamma instantiates a gamma distribution. Both shape and scale
+// must be positive numbers.
+func NewGamma(shape, scale float64) (Gamma, error) {
+	if !validPositive(shape) || !validPositive(scale) {
+		return Gamma{}, ErrBadParameter
+	}
+	return Gamma{shape: shape, scale: scale}, nil
+}
+
+// PDF evaluates the probability density function at x.
+func (g Gamma) PDF(x float64) float64 {
+	if x < 0.0 {
+		return 0.0
+	}
+	return math.Exp(xlogy(g.shape-1.0, x) - x/g.scale - lgamma(g.shape) - g.shape*math.Log(g.scale))
+}
+
+// CDF evaluates the cumulative distribution function at x.
+func (g Gamma) CDF(x float64) float64 {
+	return gammaP(g.shape, x/g.scale)
+}
+
+// Survival evaluates the survival function 1 - CDF(x), without losing
+// precision in the upper tail.
+func (g Gamma) Survival(x float64) float64 {
+	return gammaQ(g.shape, x/g.scale)
+}
+
+// Quantile evaluates the inverse of the cumulative distribution function.
+func (g Gamma) Quantile(p float64) (float64, error) {
+	if !validProbability(p) {
+		return 0.0, ErrBadProbability
+	}
+	switch p {
+	case 0.0:
+		return 0.0, nil
+	case 1.0:
+		return math.Inf(1), nil
+	}
+	return invert(g.CDF, p, 0.0, math.Inf(1)), nil
+}
+
+// Mean returns the distribution's expected value.
+func (g Gamma) Mean() float64 {
+	return g.shape * g.scale
+}
+
+// Variance returns the distribution's variance.
+func (g Gamma) Variance() float64 {
+	return g.shape * g.scale * g.scale
+}
+
+// Sample draws a random value from the distribution.
+func (g Gamma) Sample(r *rand.Rand) float64 {
+	return sampleGamma(r, g.shape) * g.scale
+}
+
+// sampleGamma draws a value from a gamma distribution with unit scale,
+// using the Marsaglia and Tsang method.
+func sampleGamma(r *rand.Rand, shape float64) float64 {
+	if shape < 1.0 {
+		return sampleGamma(r, shape+1.0) * math.Pow(r.Float64(), 1.0/shape)
+	}
+
+	d := shape - 1.0/3.0
+	c := 1.0 / math.Sqrt(9.0*d)
+	for {
+		x := r.NormFloat64()
+		v := 1.0 + c*x
+		if v <= 0.0 {
+			continue
+		}
+		v = v * v * v
+		u := r.Float64()
+		if u < 1.0-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1.0-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+// Beta is the beta distribution over the interval [0, 1].
+type Beta struct {
+	alpha float64
+	beta  float64
+}
+
+// NewBeta instantiates a beta distribution. Both shape parameters
+// must be positive numbers.
+func NewBeta(alpha, beta float64) (Beta, error) {
+	if !validPositive(alpha) || !validPositive(beta) {
+		return Beta{}, ErrBadParameter
+	}
+	return Beta{alpha: alpha, beta: beta}, nil
+}
+
+// PDF evaluates the probability density function at x.
+func (b Beta) PDF(x float64) float64 {
+	if x < 0.0 || x > 1.0 {
+		return 0.0
+	}
+	return math.Exp(xlogy(b.alpha-1.0, x) + xlogy(b.beta-1.0, 1.0-x) - lbeta(b.alpha, b.beta))
+}
+
+// CDF evaluates the cumulative distribution function at x.
+func (b Beta) CDF(x float64) float64 {
+	return betaI(x, b.alpha, b.beta)
+}
+
+// Survival evaluates the survival function 1 - CDF(x), without losing
+// precision in the upper tail.
+func (b Beta) Survival(x float64) float64 {
+	if x <= 0.0 {
+		return 1.0
+	}
+	return betaI(1.0-x, b.beta, b.alpha)
+}
+
+// Quantile evaluates the inverse of the cumulative distribution function.
+func (b Beta) Quantile(p float64) (float64, error) {
+	if !validProbability(p) {
+		return 0.0, ErrBadProbability
+	}
+	switch p {
+	case 0.0:
+		return 0.0, nil
+	case 1.0:
+		return 1.0, nil
+	}
+	return invert(b.CDF, p, 0.0, 1.0), nil
+}
+
+// Mean returns the distribution's expected value.
+func (b Beta) Mean() float64 {
+	return b.alpha / (b.alpha + b.beta)
+}
+
+// Variance returns the distribution's variance.
+func (b Beta) Variance() float64 {
+	s := b.alpha + b.beta
+	return b.alpha * b.beta / (s * s * (s + 1.0))
+}
+
+// Sample draws a random value from the distribution.
+func (b Beta) Sample(r *rand.Rand) float64 {
+	x := sampleGamma(r, b.alpha)
+	y := sampleGamma(r, b.beta)
+	return x / (x + y)
+}
+
+// ChiSquare is the chi-square distribution with the given degrees of freedom,
+// which is a gamma distribution with shape dof/2 and scale 2.
+type ChiSquare struct {
+	Gamma
+	dof float64
+}
+
+// NewChiSquare instantiates a chi-square distribution. The degrees of
+// freedom must be a positive number.
+func NewChiSquare(dof float64) (ChiSquare, error) {
+	if !validPositive(dof) {
+		return ChiSquare{}, ErrBadParameter
+	}
+	return ChiSquare{Gamma: Gamma{shape: dof / 2.0, scale: 2.0}, dof: dof}, nil
+}
+
+// DegreesOfFreedom returns the distribution's degrees of freedom.
+func (c ChiSquare) DegreesOfFreedom() float64 {
+	return c.dof
+}
+
+// StudentT is the student's t distribution with the given degrees of freedom.
+type StudentT struct {
+	dof float64
+}
+
+// NewStudentT instantiates a student's t distribution. The degrees of
+// freedom must be a positive number.
+func NewStudentT(dof float64) (StudentT, error) {
+	if !validPositive(dof) {
+		return StudentT{}, ErrBadParameter
+	}
+	return StudentT{dof: dof}, nil
+}
+
+// DegreesOfFreedom returns the distribution's degrees of freedom.
+func (t StudentT) DegreesOfFreedom() float64 {
+	return t.dof
+}
+
+// PDF evaluates the probability density function at x.
+func (t StudentT) PDF(x float64) float64 {
+	v := t.dof
+	return math.Exp(lgamma((v+1.0)/2.0)-lgamma(v/2.0)-(v+1.0)/2.0*math.Log1p(x*x/v)) / math.Sqrt(v*math.Pi)
+}
+
+// CDF evaluates the cumulative distribution function at x.
+func (t StudentT) CDF(x float64) float64 {
+	tail := 0.5 * betaI(t.dof/(t.dof+x*x), t.dof/2.0, 0.5)
+	if x < 0.0 {
+		return tail
+	}
+	return 1.0 - tail
+}
+
+// Survival evaluates the survival function 1 - CDF(x), without losing
+// precision in the upper tail.
+func (t StudentT) Survival(x float64) float64 {
+	return t.CDF(-x)
+}
+
+// Quantile evaluates the inverse of the cumulative distribution function.
+func (t StudentT) Quantile(p float64) (float64, error) {
+	if !validProbability(p) {
+		return 0.0, ErrBadProbability
+	}
+	switch p {
+	case 0.0:
+		return math.Inf(-1), nil
+	case 1.0:
+		return math.Inf(1), nil
+	}
+	return invert(t.CDF, p, math.Inf(-1), math.Inf(1)), nil
+}
+
+// Mean returns the distribution's expected value, which is
+// undefined (NaN) for one or less degrees of freedom.
+func (t StudentT) Mean() float64 {
+	if t.dof <= 1.0 {
+		return math.NaN()
+	}
+	return 0.0
+}
+
+// Variance returns the distribution's variance, which is infinite for
+// degrees of freedom in (1, 2] and undefined (NaN) for one or less.
+func (t StudentT) Variance() float64 {
+	switch {
+	case t.dof > 2.0:
+		return t.dof / (t.dof - 2.0)
+	case t.dof > 1.0:
+		return math.Inf(1)
+	default:
+		return math.NaN()
+	}
+}
+
+// Sample draws a random value from the distribution.
+func (t StudentT) Sample(r *rand.Rand) float64 {
+	chi := 2.0 * sampleGamma(r, t.dof/2.0)
+	return r.NormFloat64() / math.Sqrt(chi/t.dof)
+}
+
+// F is the fisher-snedecor distribution with the given degrees of freedom.
+type F struct {
+	d1 float64
+	d2 float64
+}
+
+// NewF instantiates a F distribution. Both degrees of freedom
+// must be positive numbers.
+func NewF(d1, d2 float64) (F, error) {
+	if !validPositive(d1) || !validPositive(d2) {
+		return F{}, ErrBadParameter
+	}
+	return F{d1: d1, d2: d2}, nil
+}
+
+// DegreesOfFreedom returns the numerator and denominator degrees of freedom.
+func (f F) DegreesOfFreedom() (float64, float64) {
+	return f.d1, f.d2
+}
+
+// PDF evaluates the probability density function at x.
+func (f F) PDF(x float64) float64 {
+	if x < 0.0 {
+		return 0.0
+	}
+	h1, h2 := f.d1/2.0, f.d2/2.0
+	return math.Exp(h1*math.Log(f.d1/f.d2) + xlogy(h1-1.0, x) - (h1+h2)*math.Log1p(f.d1*x/f.d2) - lbeta(h1, h2))
+}
+
+// CDF evaluates the cumulative distribution function at x.
+func (f F) CDF(x float64) float64 {
+	if x <= 0.0 {
+		return 0.0
+	}
+	return betaI(f.d1*x/(f.d1*x+f.d2), f.d1/2.0, f.d2/2.0)
+}
+
+// Survival evaluates the survival function 1 - CDF(x), without losing
+// precision in the upper tail.
+func (f F) Survival(x float64) float64 {
+	if x <= 0.0 {
+		return 1.0
+	}
+	return betaI(f.d2/(f.d1*x+f.d2), f.d2/2.0, f.d1/2.0)
+}
+
+// Quantile evaluates the inverse of the cumulative distribution function.
+func (f F) Quantile(p float64) (float64, error) {
+	if !validProbability(p) {
+		return 0.0, ErrBadProbability
+	}
+	switch p {
+	case 0.0:
+		return 0.0, nil
+	case 1.0:
+		return math.Inf(1), nil
+	}
+	return invert(f.CDF, p, 0.0, math.Inf(1)), nil
+}
+
+// Mean returns the distribution's expected value, which is
+// undefined (NaN) when d2 <= 2.
+func (f F) Mean() float64 {
+	if f.d2 <= 2.0 {
+		return math.NaN()
+	}
+	return f.d2 / (f.d2 - 2.0)
+}
+
+// Variance returns the distribution's variance, which is
+// undefined (NaN) when d2 <= 4.
+func (f F) Variance() float64 {
+	if f.d2 <= 4.0 {
+		return math.NaN()
+	}
+	d := f.d2 - 2.0
+	return 2.0 * f.d2 * f.d2 * (f.d1 + f.d2 - 2.0) / (f.d1 * d * d * (f.d2 - 4.0))
+}
+
+// Sample draws a random value from the distribution.
+func (f F) Sample(r *rand.Rand) float64 {
+	x := sampleGamma(r, f.d1/2.0) / f.d1
+	y := sampleGamma(r, f.d2/2.0) / f.d2
+	return x / y
+}
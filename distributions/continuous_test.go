@@ -0,0 +1,168 @@
+package distributions
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContinuous(t *testing.T) {
+	normal, _ := NewNormal(0, 1)
+	uniform, _ := NewUniform(-1, 3)
+	exponential, _ := NewExponential(0.5)
+	gamma, _ := NewGamma(2, 3)
+	beta, _ := NewBeta(2, 3)
+	chi2, _ := NewChiSquare(2)
+	chi3, _ := NewChiSquare(3)
+	cauchy, _ := NewStudentT(1)
+	student, _ := NewStudentT(10)
+	f22, _ := NewF(2, 2)
+	f510, _ := NewF(5, 10)
+
+	distributions := map[string]Continuous{
+		"normal":      normal,
+		"uniform":     uniform,
+		"exponential": exponential,
+		"gamma":       gamma,
+		"beta":        beta,
+		"chi-square":  chi3,
+		"student":     student,
+		"f":           f510,
+	}
+
+	t.Run("constructors must validate parameters", func(t *testing.T) {
+		_, err := NewNormal(0, 0)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewNormal(math.NaN(), 1)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewUniform(1, 1)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewExponential(-1)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewGamma(1, math.Inf(1))
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewBeta(0, 1)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewChiSquare(0)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewStudentT(math.NaN())
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewF(1, 0)
+		assert.ErrorIs(t, err, ErrBadParameter)
+	})
+
+	t.Run("density functions", func(t *testing.T) {
+		assert.InDelta(t, 0.398942, normal.PDF(0), 0.000001)
+		assert.InDelta(t, 0.25, uniform.PDF(2), 0.000001)
+		assert.Equal(t, 0.0, uniform.PDF(3.5))
+		assert.InDelta(t, 0.5, exponential.PDF(0), 0.000001)
+		assert.InDelta(t, 3*math.Exp(-1)/9, gamma.PDF(3), 0.000001)
+		assert.Equal(t, 0.0, gamma.PDF(0))
+		assert.InDelta(t, 1.5, beta.PDF(0.5), 0.000001)
+		assert.InDelta(t, 0.5, chi2.PDF(0), 0.000001)
+		assert.InDelta(t, 1/math.Pi, cauchy.PDF(0), 0.000001)
+		assert.InDelta(t, 0.25, f22.PDF(1), 0.000001)
+	})
+
+	t.Run("cumulative functions", func(t *testing.T) {
+		assert.InDelta(t, 0.975002, normal.CDF(1.96), 0.000001)
+		assert.InDelta(t, 0.75, uniform.CDF(2), 0.000001)
+		assert.InDelta(t, 1-math.Exp(-1), exponential.CDF(2), 0.000001)
+		assert.InDelta(t, 1-2*math.Exp(-1), gamma.CDF(3), 0.000001)
+		assert.InDelta(t, 0.6875, beta.CDF(0.5), 0.000001)
+		assert.InDelta(t, 1-math.Exp(-1), chi2.CDF(2), 0.000001)
+		assert.InDelta(t, 0.75, cauchy.CDF(1), 0.000001)
+		assert.InDelta(t, 0.25, cauchy.CDF(-1), 0.000001)
+		assert.InDelta(t, 0.75, f22.CDF(3), 0.000001)
+	})
+
+	t.Run("survival functions", func(t *testing.T) {
+		for name, d := range distributions {
+			for _, p := range []float64{0.01, 0.5, 0.99} {
+				x, _ := d.Quantile(p)
+				s := d.(interface{ Survival(float64) float64 })
+				assert.InDelta(t, 1.0, d.CDF(x)+s.Survival(x), 0.000001, name)
+			}
+		}
+
+		// upper tails far beyond the float64 precision of 1 - CDF
+		assert.InEpsilon(t, math.Exp(-500), chi2.Survival(1000), 0.000001)
+		assert.InEpsilon(t, 0.5*math.Erfc(30/math.Sqrt2), normal.Survival(30), 0.000001)
+		assert.InEpsilon(t, math.Exp(-100), exponential.Survival(200), 0.000001)
+		assert.InEpsilon(t, 1.0/(1.0+1e20), f22.Survival(1e20), 0.000001)
+		assert.Greater(t, student.Survival(1e5), 0.0)
+		assert.Greater(t, beta.Survival(1-1e-9), 0.0)
+		assert.Equal(t, 1.0, gamma.Survival(-1))
+	})
+
+	t.Run("quantile functions", func(t *testing.T) {
+		expected := map[string]float64{
+			"normal":     1.959964,
+			"chi-square": 9.348404,
+			"student":    2.228139,
+			"f":          4.236086,
+		}
+		for name, value := range expected {
+			result, err := distributions[name].Quantile(0.975)
+			assert.Nil(t, err)
+			assert.InDelta(t, value, result, 0.00001, name)
+		}
+
+		for name, d := range distributions {
+			for _, p := range []float64{0.01, 0.25, 0.5, 0.9, 0.999} {
+				x, err := d.Quantile(p)
+				assert.Nil(t, err)
+				assert.InDelta(t, p, d.CDF(x), 0.000001, name)
+			}
+			_, err := d.Quantile(1.5)
+			assert.ErrorIs(t, err, ErrBadProbability, name)
+		}
+
+		result, _ := student.Quantile(0)
+		assert.True(t, math.IsInf(result, -1))
+		result, _ = gamma.Quantile(1)
+		assert.True(t, math.IsInf(result, 1))
+	})
+
+	t.Run("moments", func(t *testing.T) {
+		assert.InDelta(t, 1.0, uniform.Mean(), 0.000001)
+		assert.InDelta(t, 16.0/12.0, uniform.Variance(), 0.000001)
+		assert.InDelta(t, 6.0, gamma.Mean(), 0.000001)
+		assert.InDelta(t, 18.0, gamma.Variance(), 0.000001)
+		assert.InDelta(t, 0.04, beta.Variance(), 0.000001)
+		assert.InDelta(t, 3.0, chi3.Mean(), 0.000001)
+		assert.InDelta(t, 6.0, chi3.Variance(), 0.000001)
+		assert.InDelta(t, 1.25, student.Variance(), 0.000001)
+		assert.True(t, math.IsNaN(cauchy.Mean()))
+		assert.InDelta(t, 1.25, f510.Mean(), 0.000001)
+		assert.InDelta(t, 1.354167, f510.Variance(), 0.000001)
+		assert.True(t, math.IsNaN(f22.Variance()))
+	})
+
+	t.Run("sampling must match moments", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		for name, d := range distributions {
+			n := 50000
+			var sum, sqr float64
+			for i := 0; i < n; i++ {
+				x := d.Sample(r)
+				sum += x
+				sqr += x * x
+			}
+			mean := sum / float64(n)
+			variance := sqr/float64(n) - mean*mean
+			assert.InDelta(t, d.Mean(), mean, 0.05*math.Max(1, math.Sqrt(d.Variance())), name)
+			assert.InEpsilon(t, d.Variance(), variance, 0.1, name)
+		}
+
+		// shapes below one use the boosted sampler
+		small, _ := NewGamma(0.5, 2)
+		var sum float64
+		for i := 0; i < 50000; i++ {
+			sum += small.Sample(r)
+		}
+		assert.InDelta(t, small.Mean(), sum/50000, 0.05)
+	})
+}
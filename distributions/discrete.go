@@ -0,0 +1,207 @@
+package distributions
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Poisson is the poisson distribution with the given mean (lambda).
+type Poisson struct {
+	lambda float64
+}
+
+// NewPoisson instantiates a poisson distribution. Lambda must be
+// a positive number.
+func NewPoisson(lambda float64) (Poisson, error) {
+	if !validPositive(lambda) {
+		return Poisson{}, ErrBadParameter
+	}
+	return Poisson{lambda: lambda}, nil
+}
+
+// PMF evaluates the probability mass function at k.
+func (p Poisson) PMF(k int) float64 {
+	if k < 0 {
+		return 0.0
+	}
+	return math.Exp(float64(k)*math.Log(p.lambda) - p.lambda - lgamma(float64(k)+1.0))
+}
+
+// CDF evaluates the cumulative distribution function at k.
+func (p Poisson) CDF(k int) float64 {
+	if k < 0 {
+		return 0.0
+	}
+	return gammaQ(float64(k)+1.0, p.lambda)
+}
+
+// Quantile returns the smallest k such that CDF(k) >= prob.
+// As the support is unbounded, math.MaxInt is returned when prob is 1.
+func (p Poisson) Quantile(prob float64) (int, error) {
+	if !validProbability(prob) {
+		return 0, ErrBadProbability
+	}
+	if prob == 1.0 {
+		return math.MaxInt, nil
+	}
+	start := normalStart(p.lambda, math.Sqrt(p.lambda), prob)
+	if start == math.MaxInt {
+		return math.MaxInt, nil
+	}
+	return discreteQuantile(prob, start, p.CDF, p.PMF), nil
+}
+
+// Mean returns the distribution's expected value.
+func (p Poisson) Mean() float64 {
+	return p.lambda
+}
+
+// Variance returns the distribution's variance.
+func (p Poisson) Variance() float64 {
+	return p.lambda
+}
+
+// Sample draws a random value from the distribution.
+func (p Poisson) Sample(r *rand.Rand) int {
+	k, _ := p.Quantile(r.Float64())
+	return k
+}
+
+// Binomial is the distribution of the number of successes in n
+// independent trials with success probability p.
+type Binomial struct {
+	n int
+	p float64
+}
+
+// NewBinomial instantiates a binomial distribution. The number of trials
+// must not be negative and p must be in the interval [0, 1].
+func NewBinomial(n int, p float64) (Binomial, error) {
+	if n < 0 || !validProbability(p) {
+		return Binomial{}, ErrBadParameter
+	}
+	return Binomial{n: n, p: p}, nil
+}
+
+// PMF evaluates the probability mass function at k.
+func (b Binomial) PMF(k int) float64 {
+	if k < 0 || k > b.n {
+		return 0.0
+	}
+	n, x := float64(b.n), float64(k)
+	lchoose := lgamma(n+1.0) - lgamma(x+1.0) - lgamma(n-x+1.0)
+	return math.Exp(lchoose + xlogy(x, b.p) + xlogy(n-x, 1.0-b.p))
+}
+
+// CDF evaluates the cumulative distribution function at k.
+func (b Binomial) CDF(k int) float64 {
+	switch {
+	case k < 0:
+		return 0.0
+	case k >= b.n:
+		return 1.0
+	default:
+		return betaI(1.0-b.p, float64(b.n-k), float64(k)+1.0)
+	}
+}
+
+// Quantile returns the smallest k such that CDF(k) >= prob.
+func (b Binomial) Quantile(prob float64) (int, error) {
+	if !validProbability(prob) {
+		return 0, ErrBadProbability
+	}
+	start := min(normalStart(b.Mean(), math.Sqrt(b.Variance()), prob), b.n)
+	return min(discreteQuantile(prob, start, b.CDF, b.PMF), b.n), nil
+}
+
+// Mean returns the distribution's expected value.
+func (b Binomial) Mean() float64 {
+	return float64(b.n) * b.p
+}
+
+// Variance returns the distribution's variance.
+func (b Binomial) Variance() float64 {
+	return float64(b.n) * b.p * (1.0 - b.p)
+}
+
+// Sample draws a random value from the distribution.
+func (b Binomial) Sample(r *rand.Rand) int {
+	k, _ := b.Quantile(r.Float64())
+	return k
+}
+
+// Geometric is the distribution of the number of independent trials
+// needed to get the first success, with success probability p.
+// Its support starts at 1.
+type Geometric struct {
+	p float64
+}
+
+// NewGeometric instantiates a geometric distribution. The success
+// probability must be in the interval (0, 1].
+func NewGeometric(p float64) (Geometric, error) {
+	if !(p > 0.0 && p <= 1.0) {
+		return Geometric{}, ErrBadParameter
+	}
+	return Geometric{p: p}, nil
+}
+
+// PMF evaluates the probability mass function at k.
+func (g Geometric) PMF(k int) float64 {
+	if k < 1 {
+		return 0.0
+	}
+	return g.p * math.Exp(xlogy(float64(k-1), 1.0-g.p))
+}
+
+// CDF evaluates the cumulative distribution function at k.
+func (g Geometric) CDF(k int) float64 {
+	if k < 1 {
+		return 0.0
+	}
+	return -math.Expm1(float64(k) * math.Log1p(-g.p))
+}
+
+// Quantile returns the smallest k such that CDF(k) >= prob.
+// As the support is unbounded, math.MaxInt is returned when prob is 1
+// and the success probability is lesser than 1.
+func (g Geometric) Quantile(prob float64) (int, error) {
+	if !validProbability(prob) {
+		return 0, ErrBadProbability
+	}
+	if g.p == 1.0 {
+		return 1, nil
+	}
+	if prob == 1.0 {
+		return math.MaxInt, nil
+	}
+
+	// closed form, adjusted for rounding errors
+	f := math.Ceil(math.Log1p(-prob) / math.Log1p(-g.p))
+	if f >= math.MaxInt {
+		return math.MaxInt, nil
+	}
+	k := max(int(f), 1)
+	if k > 1 && g.CDF(k-1) >= prob {
+		k--
+	} else if g.CDF(k) < prob {
+		k++
+	}
+	return k, nil
+}
+
+// Mean returns the distribution's expected value.
+func (g Geometric) Mean() float64 {
+	return 1.0 / g.p
+}
+
+// Variance returns the distribution's variance.
+func (g Geometric) Variance() float64 {
+	return (1.0 - g.p) / (g.p * g.p)
+}
+
+// Sample draws a random value from the distribution.
+func (g Geometric) Sample(r *rand.Rand) int {
+	k, _ := g.Quantile(r.Float64())
+	return k
+}
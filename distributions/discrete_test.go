@@ -0,0 +1,139 @@
+package distributions
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscrete(t *testing.T) {
+	poisson, _ := NewPoisson(3)
+	large, _ := NewPoisson(1000)
+	binomial, _ := NewBinomial(10, 0.5)
+	geometric, _ := NewGeometric(0.25)
+
+	distributions := map[string]Discrete{
+		"poisson":       poisson,
+		"large poisson": large,
+		"binomial":      binomial,
+		"geometric":     geometric,
+	}
+
+	t.Run("constructors must validate parameters", func(t *testing.T) {
+		_, err := NewPoisson(0)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewBinomial(-1, 0.5)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewBinomial(10, 1.5)
+		assert.ErrorIs(t, err, ErrBadParameter)
+		_, err = NewGeometric(0)
+		assert.ErrorIs(t, err, ErrBadParameter)
+	})
+
+	t.Run("mass and cumulative functions", func(t *testing.T) {
+		assert.InDelta(t, 4.5*math.Exp(-3), poisson.PMF(2), 0.000001)
+		assert.InDelta(t, 8.5*math.Exp(-3), poisson.CDF(2), 0.000001)
+		assert.Equal(t, 0.0, poisson.PMF(-1))
+
+		assert.InDelta(t, 252.0/1024.0, binomial.PMF(5), 0.000001)
+		assert.InDelta(t, 638.0/1024.0, binomial.CDF(5), 0.000001)
+		assert.Equal(t, 0.0, binomial.PMF(11))
+		assert.Equal(t, 1.0, binomial.CDF(10))
+
+		assert.InDelta(t, 0.140625, geometric.PMF(3), 0.000001)
+		assert.InDelta(t, 0.578125, geometric.CDF(3), 0.000001)
+		assert.Equal(t, 0.0, geometric.PMF(0))
+
+		for name, d := range distributions {
+			var sum float64
+			for k := 0; k < 2000; k++ {
+				sum += d.PMF(k)
+				assert.InDelta(t, sum, d.CDF(k), 0.000001, name)
+			}
+		}
+	})
+
+	t.Run("degenerate binomials", func(t *testing.T) {
+		never, _ := NewBinomial(5, 0)
+		always, _ := NewBinomial(5, 1)
+		assert.Equal(t, 1.0, never.PMF(0))
+		assert.Equal(t, 1.0, always.PMF(5))
+		assert.Equal(t, 0.0, always.PMF(4))
+
+		k, _ := never.Quantile(0.5)
+		assert.Equal(t, 0, k)
+		k, _ = always.Quantile(0.5)
+		assert.Equal(t, 5, k)
+	})
+
+	t.Run("quantile functions", func(t *testing.T) {
+		k, _ := poisson.Quantile(0.5)
+		assert.Equal(t, 3, k)
+		k, _ = binomial.Quantile(0.5)
+		assert.Equal(t, 5, k)
+		k, _ = geometric.Quantile(0.5)
+		assert.Equal(t, 3, k)
+		k, _ = poisson.Quantile(1)
+		assert.Equal(t, math.MaxInt, k)
+
+		// quantiles beyond the int range must not overflow
+		tiny, _ := NewGeometric(1e-20)
+		k, err := tiny.Quantile(0.5)
+		assert.Nil(t, err)
+		assert.Equal(t, math.MaxInt, k)
+		assert.GreaterOrEqual(t, tiny.Sample(rand.New(rand.NewSource(1))), 1)
+
+		small, _ := NewGeometric(1e-10)
+		k, _ = small.Quantile(0.5)
+		assert.InDelta(t, math.Ln2*1e10, float64(k), 1)
+
+		huge, _ := NewPoisson(1e300)
+		k, err = huge.Quantile(0.5)
+		assert.Nil(t, err)
+		assert.Equal(t, math.MaxInt, k)
+
+		for name, d := range distributions {
+			for _, p := range []float64{0.001, 0.1, 0.5, 0.77, 0.999} {
+				k, err := d.Quantile(p)
+				assert.Nil(t, err)
+				assert.GreaterOrEqual(t, d.CDF(k), p, name)
+				assert.Less(t, d.CDF(k-1), p, name)
+			}
+			_, err := d.Quantile(-0.1)
+			assert.ErrorIs(t, err, ErrBadProbability, name)
+		}
+	})
+
+	t.Run("sampling must match moments", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		for name, d := range distributions {
+			n := 20000
+			var sum, sqr float64
+			for i := 0; i < n; i++ {
+				x := float64(d.Sample(r))
+				sum += x
+				sqr += x * x
+			}
+			mean := sum / float64(n)
+			assert.InEpsilon(t, d.Mean(), mean, 0.05, name)
+			assert.InEpsilon(t, d.Variance(), sqr/float64(n)-mean*mean, 0.1, name)
+		}
+	})
+
+	t.Run("expected histogram", func(t *testing.T) {
+		b, _ := NewBinomial(4, 0.5)
+		h, err := ExpectedHistogram(b, 16, 4)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 4, 6, 4, 1}, h.Values())
+		assert.Equal(t, 16, h.Total())
+
+		h, err = ExpectedHistogram(geometric, 64, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{0, 16, 12, 9}, h.Values())
+
+		_, err = ExpectedHistogram(b, -1, 4)
+		assert.ErrorIs(t, err, ErrBadParameter)
+	})
+}
@@ -0,0 +1,86 @@
+// # Distributions
+//
+// This package contains continuous and discrete probability distributions,
+// with their density or mass functions, cumulative distribution functions,
+// quantiles, moments and sampling.
+package distributions
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/jgardona/cmath/histogram"
+)
+
+var (
+	ErrBadParameter   = errors.New("distribution parameter is out of its domain")
+	ErrBadProbability = errors.New("probability must be in the interval [0, 1]")
+)
+
+// Continuous is a probability distribution over real numbers.
+//
+// # Note
+//
+// The continuous distributions of this package also have a Survival method,
+// which evaluates 1 - CDF(x) without losing precision in the upper tail.
+type Continuous interface {
+	// PDF evaluates the probability density function at x.
+	PDF(x float64) float64
+	// CDF evaluates the cumulative distribution function at x.
+	CDF(x float64) float64
+	// Quantile evaluates the inverse of the cumulative distribution function.
+	Quantile(p float64) (float64, error)
+	// Mean returns the distribution's expected value.
+	Mean() float64
+	// Variance returns the distribution's variance.
+	Variance() float64
+	// Sample draws a random value from the distribution.
+	Sample(r *rand.Rand) float64
+}
+
+// Discrete is a probability distribution over integer numbers.
+type Discrete interface {
+	// PMF evaluates the probability mass function at k.
+	PMF(k int) float64
+	// CDF evaluates the cumulative distribution function at k.
+	CDF(k int) float64
+	// Quantile returns the smallest k such that CDF(k) >= p.
+	Quantile(p float64) (int, error)
+	// Mean returns the distribution's expected value.
+	Mean() float64
+	// Variance returns the distribution's variance.
+	Variance() float64
+	// Sample draws a random value from the distribution.
+	Sample(r *rand.Rand) int
+}
+
+// ExpectedHistogram builds a histogram with the expected counts of
+// each value in the interval [0, max] when total values are drawn
+// from the distribution.
+//
+// # Note
+//
+// Counts are rounded to the nearest integer, so the histogram total may
+// differ slightly from the requested total.
+func ExpectedHistogram(d Discrete, total, max int) (histogram.Histogram, error) {
+	if total < 0 || max < 0 {
+		return histogram.Histogram{}, ErrBadParameter
+	}
+
+	values := make([]int, max+1)
+	for k := range values {
+		values[k] = int(math.Round(float64(total) * d.PMF(k)))
+	}
+	return histogram.NewHistogram(values), nil
+}
+
+// validProbability checks if p is in the interval [0, 1].
+func validProbability(p float64) bool {
+	return p >= 0.0 && p <= 1.0
+}
+
+// validPositive checks if v is a finite positive number.
+func validPositive(v float64) bool {
+	return v > 0.0 && !math.IsInf(v, 1)
+}
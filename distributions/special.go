@@ -0,0 +1,240 @@
+package distributions
+
+import "math"
+
+const (
+	maxIterations = 1000
+	epsilon       = 1e-15
+	tiny          = 1e-300
+)
+
+// lgamma returns the natural logarithm of the absolute value of the gamma function.
+func lgamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
+
+// lbeta returns the natural logarithm of the beta function.
+func lbeta(a, b float64) float64 {
+	return lgamma(a) + lgamma(b) - lgamma(a+b)
+}
+
+// xlogy returns x*log(y), defined as zero when x is zero.
+func xlogy(x, y float64) float64 {
+	if x == 0.0 {
+		return 0.0
+	}
+	return x * math.Log(y)
+}
+
+// gammaSeries evaluates the regularized lower incomplete gamma function
+// using its series representation, which converges fast for x < a+1.
+func gammaSeries(a, x float64) float64 {
+	ap, del := a, 1.0/a
+	sum := del
+	for i := 0; i < maxIterations; i++ {
+		ap++
+		del *= x / ap
+		sum += del
+		if math.Abs(del) < math.Abs(sum)*epsilon {
+			break
+		}
+	}
+	return sum * math.Exp(-x+a*math.Log(x)-lgamma(a))
+}
+
+// gammaFraction evaluates the regularized upper incomplete gamma function
+// using its continued fraction, which converges fast for x >= a+1.
+func gammaFraction(a, x float64) float64 {
+	b := x + 1.0 - a
+	c := 1.0 / tiny
+	d := 1.0 / b
+	h := d
+	for i := 1; i < maxIterations; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2.0
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1.0 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1.0) < epsilon {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lgamma(a)) * h
+}
+
+// gammaP evaluates the regularized lower incomplete gamma function P(a, x).
+func gammaP(a, x float64) float64 {
+	switch {
+	case x <= 0.0:
+		return 0.0
+	case math.IsInf(x, 1):
+		return 1.0
+	case x < a+1.0:
+		return gammaSeries(a, x)
+	default:
+		return 1.0 - gammaFraction(a, x)
+	}
+}
+
+// gammaQ evaluates the regularized upper incomplete gamma function Q(a, x).
+func gammaQ(a, x float64) float64 {
+	switch {
+	case x <= 0.0:
+		return 1.0
+	case math.IsInf(x, 1):
+		return 0.0
+	case x < a+1.0:
+		return 1.0 - gammaSeries(a, x)
+	default:
+		return gammaFraction(a, x)
+	}
+}
+
+// betaFraction evaluates the continued fraction of the incomplete beta function.
+func betaFraction(x, a, b float64) float64 {
+	qab, qap, qam := a+b, a+1.0, a-1.0
+	c := 1.0
+	d := 1.0 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1.0 / d
+	h := d
+	for i := 1; i < maxIterations; i++ {
+		m := float64(i)
+		m2 := 2.0 * m
+
+		// even step
+		aa := m * (b - m) * x / ((qam + m2) * (a + m2))
+		d = 1.0 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1.0 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1.0 / d
+		h *= d * c
+
+		// odd step
+		aa = -(a + m) * (qab + m) * x / ((a + m2) * (qap + m2))
+		d = 1.0 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1.0 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1.0 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1.0) < epsilon {
+			break
+		}
+	}
+	return h
+}
+
+// betaI evaluates the regularized incomplete beta function I_x(a, b).
+func betaI(x, a, b float64) float64 {
+	if x <= 0.0 {
+		return 0.0
+	}
+	if x >= 1.0 {
+		return 1.0
+	}
+	bt := math.Exp(a*math.Log(x) + b*math.Log1p(-x) - lbeta(a, b))
+	if x < (a+1.0)/(a+b+2.0) {
+		return bt * betaFraction(x, a, b) / a
+	}
+	return 1.0 - bt*betaFraction(1.0-x, b, a)/b
+}
+
+// invert finds x in the interval [lo, hi] such that cdf(x) = p using
+// bisection. Infinite bounds are replaced by expanding brackets.
+func invert(cdf func(float64) float64, p, lo, hi float64) float64 {
+	if math.IsInf(lo, -1) {
+		lo = -1.0
+		if !math.IsInf(hi, 1) {
+			lo = min(lo, hi-1.0)
+		}
+		for cdf(lo) > p {
+			lo *= 2.0
+		}
+	}
+	if math.IsInf(hi, 1) {
+		hi = max(1.0, lo+1.0)
+		for cdf(hi) < p {
+			hi *= 2.0
+		}
+	}
+
+	for i := 0; i < maxIterations; i++ {
+		mid := lo + (hi-lo)/2.0
+		if cdf(mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+		if hi-lo <= epsilon*max(1.0, math.Abs(mid)) {
+			break
+		}
+	}
+	return lo + (hi-lo)/2.0
+}
+
+// normalStart approximates the prob quantile of a discrete distribution with
+// a normal distribution, clamped to [0, math.MaxInt], to start the search of
+// the exact quantile close to it.
+func normalStart(mean, stdDev, prob float64) int {
+	x := math.Floor(mean + stdDev*math.Sqrt2*math.Erfinv(2.0*prob-1.0))
+	switch {
+	case !(x > 0.0):
+		return 0
+	case x >= math.MaxInt:
+		return math.MaxInt
+	default:
+		return int(x)
+	}
+}
+
+// discreteQuantile returns the smallest k such that cdf(k) >= p, walking
+// from start with the probability mass function. The start should be close
+// to the distribution's mode.
+func discreteQuantile(p float64, start int, cdf, pmf func(int) float64) int {
+	k := start
+	c := cdf(k)
+	if c >= p {
+		for k > 0 {
+			prev := c - pmf(k)
+			if prev < p {
+				break
+			}
+			c = prev
+			k--
+		}
+		return k
+	}
+
+	for c < p && k < math.MaxInt {
+		k++
+		m := pmf(k)
+		if m == 0.0 {
+			// the remaining mass underflows
+			break
+		}
+		c += m
+	}
+	return k
+}
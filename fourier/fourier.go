@@ -0,0 +1,292 @@
+// # Fourier
+//
+// This package contains discrete and fast fourier transforms for one
+// and two dimensional data, and helpers to calculate spectra.
+package fourier
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+
+	"github.com/jgardona/cmath"
+	"github.com/jgardona/cmath/complex"
+)
+
+// Direction is the direction of a fourier transform.
+type Direction int
+
+const (
+	// Forward transforms data from the time (or space) domain to the frequency domain.
+	Forward Direction = iota
+	// Backward transforms data from the frequency domain back to the time (or space) domain.
+	Backward
+)
+
+var (
+	ErrEmptyData    = errors.New("cant transform empty data")
+	ErrNotPowerOf2  = errors.New("data length must be a power of 2")
+	ErrRaggedData   = errors.New("all rows must have the same length")
+	ErrBadDirection = errors.New("invalid transform direction")
+)
+
+// sign returns the sign of the exponent used by the transform direction.
+func (d Direction) sign() (float64, error) {
+	switch d {
+	case Forward:
+		return -1.0, nil
+	case Backward:
+		return 1.0, nil
+	default:
+		return 0.0, ErrBadDirection
+	}
+}
+
+// twiddle returns exp(sign * 2 * Pi * i * k / n).
+func twiddle(sign float64, k, n int) complex128 {
+	return cmplx.Rect(1.0, sign*2.0*math.Pi*float64(k)/float64(n))
+}
+
+// scale normalizes the result of a backward transform by 1/n.
+func scale(data []complex.Complex, direction Direction) {
+	if direction != Backward {
+		return
+	}
+	n := float64(len(data))
+	for i := range data {
+		data[i] = data[i].DivScalar(n)
+	}
+}
+
+// DFT calculates the discrete fourier transform in O(n²).
+//
+// # Note
+//
+// The backward transform is normalized by 1/n, so DFT(DFT(x, Forward), Backward)
+// returns x.
+func DFT(data []complex.Complex, direction Direction) ([]complex.Complex, error) {
+	n := len(data)
+	if n == 0 {
+		return nil, ErrEmptyData
+	}
+	sign, err := direction.sign()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]complex.Complex, n)
+	for k := 0; k < n; k++ {
+		var sum complex128
+		for j, e := range data {
+			sum += complex128(e) * twiddle(sign, (j*k)%n, n)
+		}
+		result[k] = complex.Complex(sum)
+	}
+	scale(result, direction)
+	return result, nil
+}
+
+// FFTRadix2 calculates the fast fourier transform using the iterative
+// radix-2 Cooley-Tukey algorithm. The data length must be a power of 2.
+//
+// # Note
+//
+// The backward transform is normalized by 1/n.
+func FFTRadix2(data []complex.Complex, direction Direction) ([]complex.Complex, error) {
+	n := len(data)
+	if n == 0 {
+		return nil, ErrEmptyData
+	}
+	if !cmath.IsPowerOf2(n) {
+		return nil, ErrNotPowerOf2
+	}
+	sign, err := direction.sign()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]complex.Complex, n)
+	copy(result, data)
+
+	// bit reversal permutation
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			result[i], result[j] = result[j], result[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		half := size >> 1
+		for k := 0; k < half; k++ {
+			w := complex.Complex(twiddle(sign, k, size))
+			for start := 0; start < n; start += size {
+				even := result[start+k]
+				odd := result[start+k+half] * w
+				result[start+k] = even + odd
+				result[start+k+half] = even - odd
+			}
+		}
+	}
+	scale(result, direction)
+	return result, nil
+}
+
+// FFT calculates the fast fourier transform for data of any length.
+//
+// Power of 2 lengths use FFTRadix2, other lengths use a recursive
+// mixed-radix Cooley-Tukey algorithm, which splits the data by its
+// prime factors. Lengths with large prime factors degrade towards O(n²).
+//
+// # Note
+//
+// The backward transform is normalized by 1/n.
+func FFT(data []complex.Complex, direction Direction) ([]complex.Complex, error) {
+	n := len(data)
+	if n == 0 {
+		return nil, ErrEmptyData
+	}
+	if cmath.IsPowerOf2(n) {
+		return FFTRadix2(data, direction)
+	}
+	sign, err := direction.sign()
+	if err != nil {
+		return nil, err
+	}
+
+	result := mixedRadix(data, sign)
+	scale(result, direction)
+	return result, nil
+}
+
+// smallestFactor returns the smallest prime factor of n.
+func smallestFactor(n int) int {
+	for p := 2; p*p <= n; p++ {
+		if n%p == 0 {
+			return p
+		}
+	}
+	return n
+}
+
+// mixedRadix implements an unnormalized recursive mixed-radix transform.
+func mixedRadix(data []complex.Complex, sign float64) []complex.Complex {
+	n := len(data)
+	if n == 1 {
+		return []complex.Complex{data[0]}
+	}
+
+	p := smallestFactor(n)
+	m := n / p
+
+	// transform the p decimated subsequences x[r], x[r+p], x[r+2p], ...
+	subs := make([][]complex.Complex, p)
+	buffer := make([]complex.Complex, m)
+	for r := 0; r < p; r++ {
+		for j := 0; j < m; j++ {
+			buffer[j] = data[r+j*p]
+		}
+		if m == 1 {
+			subs[r] = []complex.Complex{buffer[0]}
+		} else {
+			subs[r] = mixedRadix(buffer, sign)
+		}
+	}
+
+	result := make([]complex.Complex, n)
+	for k := 0; k < n; k++ {
+		var sum complex128
+		for r := 0; r < p; r++ {
+			sum += complex128(subs[r][k%m]) * twiddle(sign, (r*k)%n, n)
+		}
+		result[k] = complex.Complex(sum)
+	}
+	return result
+}
+
+// RealFFT calculates the forward fast fourier transform of real valued data.
+// Since the spectrum of real data is conjugate symmetric, only the
+// non-negative frequencies are returned, i.e. n/2+1 values.
+func RealFFT(data []float64) ([]complex.Complex, error) {
+	values := make([]complex.Complex, len(data))
+	for i, e := range data {
+		values[i] = complex.NewComplex(e, 0)
+	}
+
+	spectrum, err := FFT(values, Forward)
+	if err != nil {
+		return nil, err
+	}
+	return spectrum[:len(data)/2+1], nil
+}
+
+// FFT2 calculates the two dimensional fast fourier transform of a grid,
+// transforming all rows and then all columns. All rows must have the
+// same length, which may be any length supported by FFT.
+func FFT2(data [][]complex.Complex, direction Direction) ([][]complex.Complex, error) {
+	rows := len(data)
+	if rows == 0 || len(data[0]) == 0 {
+		return nil, ErrEmptyData
+	}
+	cols := len(data[0])
+	for _, row := range data {
+		if len(row) != cols {
+			return nil, ErrRaggedData
+		}
+	}
+
+	result := make([][]complex.Complex, rows)
+	for i, row := range data {
+		transformed, err := FFT(row, direction)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = transformed
+	}
+
+	column := make([]complex.Complex, rows)
+	for j := 0; j < cols; j++ {
+		for i := 0; i < rows; i++ {
+			column[i] = result[i][j]
+		}
+		transformed, err := FFT(column, direction)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < rows; i++ {
+			result[i][j] = transformed[i]
+		}
+	}
+	return result, nil
+}
+
+// MagnitudeSpectrum returns the magnitude of each frequency component.
+func MagnitudeSpectrum(spectrum []complex.Complex) []float64 {
+	result := make([]float64, len(spectrum))
+	for i, e := range spectrum {
+		result[i] = e.Magnitude()
+	}
+	return result
+}
+
+// PowerSpectrum returns the power (squared magnitude) of each frequency component.
+func PowerSpectrum(spectrum []complex.Complex) []float64 {
+	result := make([]float64, len(spectrum))
+	for i, e := range spectrum {
+		result[i] = e.SquaredMagnitude()
+	}
+	return result
+}
+
+// PhaseSpectrum returns the phase, in radians, of each frequency component.
+func PhaseSpectrum(spectrum []complex.Complex) []float64 {
+	result := make([]float64, len(spectrum))
+	for i, e := range spectrum {
+		result[i] = e.Phase()
+	}
+	return result
+}
@@ -0,0 +1,157 @@
+package fourier
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jgardona/cmath/complex"
+	"github.com/stretchr/testify/assert"
+)
+
+func signal(n int) []complex.Complex {
+	data := make([]complex.Complex, n)
+	for i := range data {
+		data[i] = complex.NewComplex(math.Sin(float64(i)*0.7)+float64(i%3), math.Cos(float64(i)*1.3))
+	}
+	return data
+}
+
+func assertSliceDelta(t *testing.T, expected, result []complex.Complex) {
+	t.Helper()
+	assert.Equal(t, len(expected), len(result))
+	for i := range expected {
+		assert.True(t, expected[i].Equals(result[i], 0.0001), "index %d: %v != %v", i, expected[i], result[i])
+	}
+}
+
+func TestFourier(t *testing.T) {
+	t.Run("test dft of an impulse and a constant", func(t *testing.T) {
+		impulse := []complex.Complex{1, 0, 0, 0}
+		result, err := DFT(impulse, Forward)
+		assert.Nil(t, err)
+		assertSliceDelta(t, []complex.Complex{1, 1, 1, 1}, result)
+
+		result, err = DFT([]complex.Complex{1, 1, 1, 1}, Forward)
+		assert.Nil(t, err)
+		assertSliceDelta(t, []complex.Complex{4, 0, 0, 0}, result)
+	})
+
+	t.Run("test dft known values", func(t *testing.T) {
+		result, err := DFT([]complex.Complex{1, 2, 3, 4}, Forward)
+		assert.Nil(t, err)
+		expected := []complex.Complex{10, complex.NewComplex(-2, 2), -2, complex.NewComplex(-2, -2)}
+		assertSliceDelta(t, expected, result)
+	})
+
+	t.Run("test fft matches dft for every length", func(t *testing.T) {
+		for n := 1; n <= 40; n++ {
+			data := signal(n)
+			expected, err := DFT(data, Forward)
+			assert.Nil(t, err)
+			result, err := FFT(data, Forward)
+			assert.Nil(t, err)
+			assertSliceDelta(t, expected, result)
+		}
+	})
+
+	t.Run("test backward transforms invert forward transforms", func(t *testing.T) {
+		for _, n := range []int{1, 6, 16, 17, 30} {
+			data := signal(n)
+			spectrum, _ := FFT(data, Forward)
+			result, err := FFT(spectrum, Backward)
+			assert.Nil(t, err)
+			assertSliceDelta(t, data, result)
+
+			spectrum, _ = DFT(data, Forward)
+			result, err = DFT(spectrum, Backward)
+			assert.Nil(t, err)
+			assertSliceDelta(t, data, result)
+		}
+	})
+
+	t.Run("test fft does not modify its input", func(t *testing.T) {
+		data := signal(8)
+		original := append([]complex.Complex{}, data...)
+		_, _ = FFT(data, Forward)
+		assert.Equal(t, original, data)
+	})
+
+	t.Run("test radix2 rejects other lengths", func(t *testing.T) {
+		_, err := FFTRadix2(signal(6), Forward)
+		assert.ErrorIs(t, err, ErrNotPowerOf2)
+
+		result, err := FFTRadix2(signal(8), Forward)
+		assert.Nil(t, err)
+		expected, _ := DFT(signal(8), Forward)
+		assertSliceDelta(t, expected, result)
+	})
+
+	t.Run("test errors", func(t *testing.T) {
+		_, err := DFT(nil, Forward)
+		assert.ErrorIs(t, err, ErrEmptyData)
+		_, err = FFT([]complex.Complex{}, Forward)
+		assert.ErrorIs(t, err, ErrEmptyData)
+		_, err = FFT(signal(4), Direction(7))
+		assert.ErrorIs(t, err, ErrBadDirection)
+		_, err = RealFFT(nil)
+		assert.ErrorIs(t, err, ErrEmptyData)
+	})
+
+	t.Run("test real fft", func(t *testing.T) {
+		data := []float64{1, 2, 3, 4, 5}
+		result, err := RealFFT(data)
+		assert.Nil(t, err)
+		assert.Len(t, result, 3)
+		assert.True(t, result[0].Equals(15, 0.0001))
+
+		full, _ := DFT([]complex.Complex{1, 2, 3, 4, 5}, Forward)
+		assertSliceDelta(t, full[:3], result)
+	})
+
+	t.Run("test fft2", func(t *testing.T) {
+		grid := [][]complex.Complex{
+			{1, 2, 3},
+			{4, 5, 6},
+		}
+		result, err := FFT2(grid, Forward)
+		assert.Nil(t, err)
+		assert.True(t, result[0][0].Equals(21, 0.0001))
+		assert.True(t, result[1][0].Equals(-9, 0.0001))
+		assert.True(t, result[1][1].Equals(0, 0.0001))
+
+		back, err := FFT2(result, Backward)
+		assert.Nil(t, err)
+		for i := range grid {
+			assertSliceDelta(t, grid[i], back[i])
+		}
+
+		_, err = FFT2([][]complex.Complex{{1, 2}, {3}}, Forward)
+		assert.ErrorIs(t, err, ErrRaggedData)
+		_, err = FFT2(nil, Forward)
+		assert.ErrorIs(t, err, ErrEmptyData)
+	})
+
+	t.Run("test spectra", func(t *testing.T) {
+		spectrum := []complex.Complex{complex.NewComplex(3, 4), complex.NewComplex(0, -2)}
+		assert.Equal(t, []float64{5, 2}, MagnitudeSpectrum(spectrum))
+		assert.Equal(t, []float64{25, 4}, PowerSpectrum(spectrum))
+		phase := PhaseSpectrum(spectrum)
+		assert.InDelta(t, -math.Pi/2, phase[1], 0.0001)
+	})
+}
+
+func BenchmarkFourier(b *testing.B) {
+	data := signal(1024)
+	b.Run("benchmark radix2 fft", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = FFT(data, Forward)
+		}
+	})
+
+	odd := signal(1000)
+	b.Run("benchmark mixed radix fft", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = FFT(odd, Forward)
+		}
+	})
+}
@@ -0,0 +1,159 @@
+// # Gaussian
+//
+// This package contains the gaussian function and tools to build
+// one and two dimensional gaussian kernels.
+package gaussian
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrBadSigma = errors.New("sigma must be a positive number")
+	ErrBadSize  = errors.New("kernel size must be an odd number greater than or equal to 3")
+	ErrBadBits  = errors.New("fixed point bits must be in the interval [1, 30]")
+)
+
+// Gaussian evaluates the gaussian function, with mean zero and the
+// specified standard deviation (sigma).
+type Gaussian struct {
+	sigma    float64
+	sqrSigma float64
+}
+
+// NewGaussian instantiates a gaussian function given its sigma value.
+//
+// # Note
+//
+// Besides non positive values, sigmas so small or so large that the
+// normalization factor 1/(2*Pi*sigma^2) can't be represented are rejected,
+// since their functions and kernels would be made of Inf or NaN values.
+func NewGaussian(sigma float64) (Gaussian, error) {
+	if !(sigma > 0.0) {
+		return Gaussian{}, ErrBadSigma
+	}
+	if norm := 2.0 * math.Pi * sigma * sigma; math.IsInf(norm, 1) || math.IsInf(1.0/norm, 1) {
+		return Gaussian{}, ErrBadSigma
+	}
+	return Gaussian{sigma: sigma, sqrSigma: sigma * sigma}, nil
+}
+
+// Sigma returns the gaussian's standard deviation.
+func (g Gaussian) Sigma() float64 {
+	return g.sigma
+}
+
+// Function evaluates the 1D gaussian function at x.
+func (g Gaussian) Function(x float64) float64 {
+	return math.Exp(x*x/(-2.0*g.sqrSigma)) / (math.Sqrt(2.0*math.Pi) * g.sigma)
+}
+
+// Function2D evaluates the 2D gaussian function at (x, y).
+func (g Gaussian) Function2D(x, y float64) float64 {
+	return math.Exp((x*x+y*y)/(-2.0*g.sqrSigma)) / (2.0 * math.Pi * g.sqrSigma)
+}
+
+// Kernel returns a 1D kernel of the given size, centered at zero.
+// The kernel is normalized, i.e. the sum of its elements is 1.
+//
+// # Note
+//
+// The size must be an odd number greater than or equal to 3.
+func (g Gaussian) Kernel(size int) ([]float64, error) {
+	if size < 3 || size%2 == 0 {
+		return nil, ErrBadSize
+	}
+
+	kernel := make([]float64, size)
+	radius := size / 2
+	sum := 0.0
+	for i := range kernel {
+		kernel[i] = g.Function(float64(i - radius))
+		sum += kernel[i]
+	}
+	for i := range kernel {
+		kernel[i] /= sum
+	}
+	return kernel, nil
+}
+
+// Kernel2D returns a 2D kernel of the given size, centered at zero.
+// The kernel is normalized, i.e. the sum of its elements is 1.
+//
+// # Note
+//
+// The size must be an odd number greater than or equal to 3.
+func (g Gaussian) Kernel2D(size int) ([][]float64, error) {
+	if size < 3 || size%2 == 0 {
+		return nil, ErrBadSize
+	}
+
+	kernel := make([][]float64, size)
+	radius := size / 2
+	sum := 0.0
+	for i := range kernel {
+		kernel[i] = make([]float64, size)
+		for j := range kernel[i] {
+			kernel[i][j] = g.Function2D(float64(j-radius), float64(i-radius))
+			sum += kernel[i][j]
+		}
+	}
+	for i := range kernel {
+		for j := range kernel[i] {
+			kernel[i][j] /= sum
+		}
+	}
+	return kernel, nil
+}
+
+// IntKernel returns a 1D kernel for fixed point processing. The normalized
+// kernel is scaled so the sum of its elements is exactly 1 << bits, which
+// allows dividing the convolution result with a right shift.
+func (g Gaussian) IntKernel(size, bits int) ([]int, error) {
+	if bits < 1 || bits > 30 {
+		return nil, ErrBadBits
+	}
+	kernel, err := g.Kernel(size)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]int, size)
+	sum := 0
+	for i, e := range kernel {
+		result[i] = int(math.Round(e * float64(int(1)<<bits)))
+		sum += result[i]
+	}
+
+	// rounding errors are compensated in the center element
+	result[size/2] += (1 << bits) - sum
+	return result, nil
+}
+
+// IntKernel2D returns a 2D kernel for fixed point processing. The normalized
+// kernel is scaled so the sum of its elements is exactly 1 << bits, which
+// allows dividing the convolution result with a right shift.
+func (g Gaussian) IntKernel2D(size, bits int) ([][]int, error) {
+	if bits < 1 || bits > 30 {
+		return nil, ErrBadBits
+	}
+	kernel, err := g.Kernel2D(size)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]int, size)
+	sum := 0
+	for i, row := range kernel {
+		result[i] = make([]int, size)
+		for j, e := range row {
+			result[i][j] = int(math.Round(e * float64(int(1)<<bits)))
+			sum += result[i][j]
+		}
+	}
+
+	// rounding errors are compensated in the center element
+	result[size/2][size/2] += (1 << bits) - sum
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package gaussian
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGaussian(t *testing.T) {
+	g, err := NewGaussian(1.0)
+	assert.Nil(t, err)
+
+	t.Run("test sigma validation", func(t *testing.T) {
+		for _, sigma := range []float64{0, -1, math.NaN(), math.Inf(1), 1e-310, 1e-160, 1e160} {
+			_, err := NewGaussian(sigma)
+			assert.ErrorIs(t, err, ErrBadSigma)
+		}
+
+		// the smallest sigmas still produce finite, normalized kernels
+		tiny, err := NewGaussian(1e-150)
+		assert.Nil(t, err)
+		kernel, err := tiny.Kernel(3)
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{0, 1, 0}, kernel)
+		kernel2D, err := tiny.Kernel2D(3)
+		assert.Nil(t, err)
+		assert.Equal(t, 1.0, kernel2D[1][1])
+		assert.Equal(t, 1.0, g.Sigma())
+	})
+
+	t.Run("test function", func(t *testing.T) {
+		assert.InDelta(t, 0.3989, g.Function(0), 0.0001)
+		assert.InDelta(t, 0.2420, g.Function(1), 0.0001)
+		assert.InDelta(t, g.Function(-2), g.Function(2), 0.0001)
+	})
+
+	t.Run("test function 2d", func(t *testing.T) {
+		assert.InDelta(t, 0.1591, g.Function2D(0, 0), 0.0001)
+		assert.InDelta(t, g.Function(1)*g.Function(2), g.Function2D(1, 2), 0.0001)
+	})
+
+	t.Run("test kernel", func(t *testing.T) {
+		kernel, err := g.Kernel(5)
+		assert.Nil(t, err)
+		assert.Len(t, kernel, 5)
+		sum := 0.0
+		for _, e := range kernel {
+			sum += e
+		}
+		assert.InDelta(t, 1.0, sum, 0.0001)
+		assert.InDelta(t, 0.4026, kernel[2], 0.0001)
+		assert.InDelta(t, kernel[0], kernel[4], 0.0001)
+
+		for _, size := range []int{-1, 1, 2, 4} {
+			_, err := g.Kernel(size)
+			assert.ErrorIs(t, err, ErrBadSize)
+		}
+	})
+
+	t.Run("test kernel 2d", func(t *testing.T) {
+		kernel, err := g.Kernel2D(3)
+		assert.Nil(t, err)
+		assert.Len(t, kernel, 3)
+		sum := 0.0
+		for _, row := range kernel {
+			assert.Len(t, row, 3)
+			for _, e := range row {
+				sum += e
+			}
+		}
+		assert.InDelta(t, 1.0, sum, 0.0001)
+		assert.InDelta(t, kernel[0][1], kernel[1][0], 0.0001)
+
+		// a 2d gaussian kernel is separable
+		k1, _ := g.Kernel(3)
+		assert.InDelta(t, k1[0]*k1[1], kernel[0][1], 0.0001)
+
+		_, err = g.Kernel2D(4)
+		assert.ErrorIs(t, err, ErrBadSize)
+	})
+
+	t.Run("test int kernel", func(t *testing.T) {
+		kernel, err := g.IntKernel(5, 8)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{14, 63, 102, 63, 14}, kernel)
+
+		_, err = g.IntKernel(5, 0)
+		assert.ErrorIs(t, err, ErrBadBits)
+		_, err = g.IntKernel(2, 8)
+		assert.ErrorIs(t, err, ErrBadSize)
+	})
+
+	t.Run("test int kernel 2d", func(t *testing.T) {
+		kernel, err := g.IntKernel2D(5, 12)
+		assert.Nil(t, err)
+		sum := 0
+		for _, row := range kernel {
+			for _, e := range row {
+				sum += e
+			}
+		}
+		assert.Equal(t, 1<<12, sum)
+		assert.Equal(t, kernel[0][0], kernel[4][4])
+
+		_, err = g.IntKernel2D(5, 31)
+		assert.ErrorIs(t, err, ErrBadBits)
+		_, err = g.IntKernel2D(0, 8)
+		assert.ErrorIs(t, err, ErrBadSize)
+	})
+}
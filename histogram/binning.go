@@ -0,0 +1,171 @@
+package histogram
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/jgardona/cmath/constraints"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// maxBins limits the number of bins a binning strategy can create.
+const maxBins = 1 << 24
+
+var (
+	ErrEmptySamples = errors.New("cant build a histogram without samples")
+	ErrBadSample    = errors.New("samples must be finite numbers")
+	ErrBadBinCount  = errors.New("bin count must be greater than or equal to 1")
+	ErrBadBinWidth  = errors.New("bin width must be a positive number")
+	ErrTooManyBins  = errors.New("binning strategy creates too many bins")
+)
+
+// Binning is a strategy that computes the bin edges for sorted samples.
+// For n bins, n+1 edges are returned, and the bin i holds the values
+// inside [edges[i], edges[i+1]), except the last bin which also holds
+// the last edge.
+type Binning func(sorted []float64) ([]float64, error)
+
+// NewHistogramFromSamples counts raw samples into a histogram, using the
+// given binning strategy. Returns the histogram and its bin edges.
+func NewHistogramFromSamples[T constraints.Numbers](samples []T, binning Binning) (Histogram, []float64, error) {
+	if len(samples) == 0 {
+		return Histogram{}, nil, ErrEmptySamples
+	}
+
+	sorted := make([]float64, len(samples))
+	for i, e := range samples {
+		v := float64(e)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return Histogram{}, nil, ErrBadSample
+		}
+		sorted[i] = v
+	}
+	sort.Float64s(sorted)
+
+	edges, err := binning(sorted)
+	if err != nil {
+		return Histogram{}, nil, err
+	}
+
+	n := len(edges) - 1
+	values := make([]int, n)
+	for _, e := range sorted {
+		// index of the last edge less than or equal to the sample
+		i := sort.Search(len(edges), func(j int) bool { return edges[j] > e }) - 1
+		if i >= n {
+			i = n - 1
+		}
+		values[i]++
+	}
+
+	return NewHistogram(values), edges, nil
+}
+
+// FixedCount returns a binning strategy which splits the samples range
+// in n bins of equal width.
+func FixedCount(n int) Binning {
+	return func(sorted []float64) ([]float64, error) {
+		if n < 1 {
+			return nil, ErrBadBinCount
+		}
+		return countEdges(sorted, n)
+	}
+}
+
+// FixedWidth returns a binning strategy which creates bins of the given
+// width, starting at the minimum sample.
+func FixedWidth(width float64) Binning {
+	return func(sorted []float64) ([]float64, error) {
+		if !(width > 0.0) || math.IsInf(width, 1) {
+			return nil, ErrBadBinWidth
+		}
+		return widthEdges(sorted, width)
+	}
+}
+
+// Sturges is a binning strategy which uses ceil(log2(n)) + 1 bins.
+// It assumes normally distributed samples and works poorly for large samples.
+func Sturges(sorted []float64) ([]float64, error) {
+	n := float64(len(sorted))
+	return countEdges(sorted, int(math.Ceil(math.Log2(n)))+1)
+}
+
+// Scott is a binning strategy which uses bins of width 3.49σn^(-1/3),
+// optimal for normally distributed samples.
+func Scott(sorted []float64) ([]float64, error) {
+	n := float64(len(sorted))
+	width := 3.49 * statistics.SampleStdDev(sorted) * math.Cbrt(1.0/n)
+	return widthEdges(sorted, width)
+}
+
+// FreedmanDiaconis is a binning strategy which uses bins of width
+// 2·IQR·n^(-1/3). It is robust to outliers.
+func FreedmanDiaconis(sorted []float64) ([]float64, error) {
+	n := float64(len(sorted))
+	width := 2.0 * statistics.SampleIQR(sorted) * math.Cbrt(1.0/n)
+	return widthEdges(sorted, width)
+}
+
+// Doane is a binning strategy which improves Sturges for non normal
+// samples, adding bins according to the samples skewness.
+func Doane(sorted []float64) ([]float64, error) {
+	n := float64(len(sorted))
+	if n < 3 {
+		return Sturges(sorted)
+	}
+	sigma := math.Sqrt(6.0 * (n - 2.0) / ((n + 1.0) * (n + 3.0)))
+	k := 1.0 + math.Log2(n) + math.Log2(1.0+math.Abs(statistics.SampleSkewness(sorted))/sigma)
+	return countEdges(sorted, int(math.Ceil(k)))
+}
+
+// limits returns the samples limits, which are widened
+// if all samples have the same value.
+func limits(sorted []float64) (float64, float64) {
+	min, max := sorted[0], sorted[len(sorted)-1]
+	if min == max {
+		return min - 0.5, max + 0.5
+	}
+	return min, max
+}
+
+// countEdges returns the edges for n bins of equal width.
+func countEdges(sorted []float64, n int) ([]float64, error) {
+	if n > maxBins {
+		return nil, ErrTooManyBins
+	}
+	min, max := limits(sorted)
+	width := (max - min) / float64(n)
+
+	edges := make([]float64, n+1)
+	for i := range edges {
+		edges[i] = min + float64(i)*width
+	}
+	// avoids rounding errors on the last edge
+	edges[n] = max
+	return edges, nil
+}
+
+// widthEdges returns the edges for bins of the given width. A width
+// of zero, which happens for samples without dispersion, creates a single bin.
+func widthEdges(sorted []float64, width float64) ([]float64, error) {
+	min, max := limits(sorted)
+	if width == 0.0 {
+		return countEdges(sorted, 1)
+	}
+
+	count := math.Ceil((max - min) / width)
+	if count > maxBins {
+		return nil, ErrTooManyBins
+	}
+	n := int(math.Max(count, 1.0))
+
+	edges := make([]float64, n+1)
+	for i := range edges {
+		edges[i] = min + float64(i)*width
+	}
+	if edges[n] < max {
+		edges[n] = max
+	}
+	return edges, nil
+}
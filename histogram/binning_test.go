@@ -0,0 +1,81 @@
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramFromSamples(t *testing.T) {
+	samples := []float64{1, 2, 2, 3, 3, 3, 4, 4, 5, 9}
+
+	t.Run("fixed count must split the range evenly", func(t *testing.T) {
+		h, edges, err := NewHistogramFromSamples(samples, FixedCount(4))
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{1, 3, 5, 7, 9}, edges)
+		assert.Equal(t, []int{3, 5, 1, 1}, h.Values())
+		assert.Equal(t, 10, h.Total())
+	})
+
+	t.Run("fixed width must start at the minimum sample", func(t *testing.T) {
+		h, edges, err := NewHistogramFromSamples([]int{0, 1, 2, 3, 4, 5, 10}, FixedWidth(3))
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{0, 3, 6, 9, 12}, edges)
+		assert.Equal(t, []int{3, 3, 0, 1}, h.Values())
+	})
+
+	t.Run("sturges must use log2(n) + 1 bins", func(t *testing.T) {
+		h, edges, err := NewHistogramFromSamples(samples, Sturges)
+		assert.Nil(t, err)
+		assert.Len(t, edges, 6)
+		assert.Equal(t, []int{3, 5, 1, 0, 1}, h.Values())
+	})
+
+	t.Run("scott must use the standard deviation", func(t *testing.T) {
+		// width = 3.49 * 2.2211 / cbrt(10) = 3.598
+		h, edges, err := NewHistogramFromSamples(samples, Scott)
+		assert.Nil(t, err)
+		assert.Len(t, edges, 4)
+		assert.InDelta(t, 4.598, edges[1], 0.001)
+		assert.Equal(t, 10, h.Total())
+	})
+
+	t.Run("freedman diaconis must use the interquartile range", func(t *testing.T) {
+		// iqr = 4 - 2.25, width = 2 * 1.75 / cbrt(10) = 1.6245
+		_, edges, err := NewHistogramFromSamples(samples, FreedmanDiaconis)
+		assert.Nil(t, err)
+		assert.Len(t, edges, 6)
+		assert.InDelta(t, 2.6245, edges[1], 0.001)
+		assert.InDelta(t, 9.122, edges[5], 0.001)
+	})
+
+	t.Run("doane must add bins for skewed samples", func(t *testing.T) {
+		_, sturges, _ := NewHistogramFromSamples(samples, Sturges)
+		_, doane, err := NewHistogramFromSamples(samples, Doane)
+		assert.Nil(t, err)
+		assert.Greater(t, len(doane), len(sturges))
+	})
+
+	t.Run("samples without dispersion must create a single bin", func(t *testing.T) {
+		for _, binning := range []Binning{Scott, FreedmanDiaconis, FixedWidth(1)} {
+			h, edges, err := NewHistogramFromSamples([]int{7, 7, 7}, binning)
+			assert.Nil(t, err)
+			assert.Equal(t, []float64{6.5, 7.5}, edges)
+			assert.Equal(t, []int{3}, h.Values())
+		}
+	})
+
+	t.Run("invalid arguments must return errors", func(t *testing.T) {
+		_, _, err := NewHistogramFromSamples([]float64{}, Sturges)
+		assert.ErrorIs(t, err, ErrEmptySamples)
+		_, _, err = NewHistogramFromSamples([]float64{1, math.NaN()}, Sturges)
+		assert.ErrorIs(t, err, ErrBadSample)
+		_, _, err = NewHistogramFromSamples(samples, FixedCount(0))
+		assert.ErrorIs(t, err, ErrBadBinCount)
+		_, _, err = NewHistogramFromSamples(samples, FixedWidth(-1))
+		assert.ErrorIs(t, err, ErrBadBinWidth)
+		_, _, err = NewHistogramFromSamples(samples, FixedWidth(1e-12))
+		assert.ErrorIs(t, err, ErrTooManyBins)
+	})
+}
@@ -0,0 +1,179 @@
+package histogram
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrBinMismatch = errors.New("histograms must have the same number of bins")
+)
+
+// The comparison functions, except Correlation, compare the histograms as
+// probability distributions (see Normalized), so histograms with different
+// totals can be compared.
+
+// probabilities validates two histograms and returns their normalized values.
+func probabilities(a, b Histogram) ([]float64, []float64, error) {
+	if len(a.values) != len(b.values) {
+		return nil, nil, ErrBinMismatch
+	}
+	if a.total == 0 || b.total == 0 {
+		return nil, nil, ErrEmptyHistogram
+	}
+	return a.Normalized(), b.Normalized(), nil
+}
+
+// Correlation calculates the pearson correlation between the histograms
+// values, in the interval [-1, 1]. Returns 1 if both histograms are flat.
+func Correlation(a, b Histogram) (float64, error) {
+	if len(a.values) != len(b.values) {
+		return 0.0, ErrBinMismatch
+	}
+	if len(a.values) == 0 {
+		return 0.0, ErrEmptyHistogram
+	}
+
+	n := float64(len(a.values))
+	ma, mb := float64(a.total)/n, float64(b.total)/n
+	var cov, va, vb float64
+	for i := range a.values {
+		da, db := float64(a.values[i])-ma, float64(b.values[i])-mb
+		cov += da * db
+		va += da * da
+		vb += db * db
+	}
+
+	if va == 0.0 && vb == 0.0 {
+		return 1.0, nil
+	}
+	if va == 0.0 || vb == 0.0 {
+		return 0.0, nil
+	}
+	return cov / math.Sqrt(va*vb), nil
+}
+
+// ChiSquare calculates the symmetric chi-square distance, i.e. the sum of
+// (p-q)²/(p+q), skipping bins which are empty in both histograms.
+// The result is in the interval [0, 2].
+func ChiSquare(a, b Histogram) (float64, error) {
+	p, q, err := probabilities(a, b)
+	if err != nil {
+		return 0.0, err
+	}
+
+	var result float64
+	for i := range p {
+		if s := p[i] + q[i]; s > 0 {
+			d := p[i] - q[i]
+			result += d * d / s
+		}
+	}
+	return result, nil
+}
+
+// Intersection calculates the histograms intersection, i.e. the sum of the
+// minimum probability of each bin. The result is in the interval [0, 1],
+// where 1 means identical distributions.
+func Intersection(a, b Histogram) (float64, error) {
+	p, q, err := probabilities(a, b)
+	if err != nil {
+		return 0.0, err
+	}
+
+	var result float64
+	for i := range p {
+		result += math.Min(p[i], q[i])
+	}
+	return result, nil
+}
+
+// bhattacharyyaCoefficient calculates the sum of sqrt(p*q).
+func bhattacharyyaCoefficient(p, q []float64) float64 {
+	var bc float64
+	for i := range p {
+		bc += math.Sqrt(p[i] * q[i])
+	}
+	return math.Min(bc, 1.0)
+}
+
+// Bhattacharyya calculates the bhattacharyya distance -ln(BC), where BC is
+// the bhattacharyya coefficient. Returns +Inf for histograms which don't overlap.
+func Bhattacharyya(a, b Histogram) (float64, error) {
+	p, q, err := probabilities(a, b)
+	if err != nil {
+		return 0.0, err
+	}
+	return -math.Log(bhattacharyyaCoefficient(p, q)), nil
+}
+
+// Hellinger calculates the hellinger distance sqrt(1 - BC), where BC is the
+// bhattacharyya coefficient. The result is in the interval [0, 1].
+func Hellinger(a, b Histogram) (float64, error) {
+	p, q, err := probabilities(a, b)
+	if err != nil {
+		return 0.0, err
+	}
+	return math.Sqrt(1.0 - bhattacharyyaCoefficient(p, q)), nil
+}
+
+// kullbackLeibler calculates the divergence of q from p in bits.
+func kullbackLeibler(p, q []float64) float64 {
+	var result float64
+	for i := range p {
+		if p[i] == 0 {
+			continue
+		}
+		if q[i] == 0 {
+			return math.Inf(1)
+		}
+		result += p[i] * math.Log2(p[i]/q[i])
+	}
+	return result
+}
+
+// KullbackLeibler calculates the kullback-leibler divergence of b from a, in
+// bits, like statistics.Entropy. The divergence is not symmetric, and is
+// +Inf if b has empty bins where a has hits.
+func KullbackLeibler(a, b Histogram) (float64, error) {
+	p, q, err := probabilities(a, b)
+	if err != nil {
+		return 0.0, err
+	}
+	return kullbackLeibler(p, q), nil
+}
+
+// JensenShannon calculates the jensen-shannon divergence, in bits, which is a
+// symmetric and always finite version of the kullback-leibler divergence.
+// The result is in the interval [0, 1].
+func JensenShannon(a, b Histogram) (float64, error) {
+	p, q, err := probabilities(a, b)
+	if err != nil {
+		return 0.0, err
+	}
+
+	m := make([]float64, len(p))
+	for i := range p {
+		m[i] = (p[i] + q[i]) / 2.0
+	}
+	return (kullbackLeibler(p, m) + kullbackLeibler(q, m)) / 2.0, nil
+}
+
+// EarthMovers calculates the earth mover's distance, i.e. the minimum amount
+// of probability times the distance, in bins, it must be moved to turn one
+// distribution into the other.
+func EarthMovers(a, b Histogram) (float64, error) {
+	if len(a.values) != len(b.values) {
+		return 0.0, ErrBinMismatch
+	}
+	if a.total == 0 || b.total == 0 {
+		return 0.0, ErrEmptyHistogram
+	}
+
+	p, q := a.CDF(), b.CDF()
+	var result float64
+	for i := range p {
+		result += math.Abs(p[i] - q[i])
+	}
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	h1 := NewHistogram([]int{1, 2, 3, 4})
+	h2 := NewHistogram([]int{2, 4, 6, 8})
+	h3 := NewHistogram([]int{4, 3, 2, 1})
+	disjoint1 := NewHistogram([]int{5, 0, 0, 0})
+	disjoint2 := NewHistogram([]int{0, 0, 0, 5})
+	short := NewHistogram([]int{1, 2})
+	empty := NewHistogram([]int{0, 0, 0, 0})
+
+	t.Run("correlation", func(t *testing.T) {
+		result, err := Correlation(h1, h2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.0, result, 0.0001)
+
+		result, _ = Correlation(h1, h3)
+		assert.InDelta(t, -1.0, result, 0.0001)
+
+		result, _ = Correlation(NewHistogram([]int{2, 2}), NewHistogram([]int{3, 3}))
+		assert.Equal(t, 1.0, result)
+	})
+
+	t.Run("chi square", func(t *testing.T) {
+		result, err := ChiSquare(h1, h2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.0, result, 0.0001)
+
+		result, _ = ChiSquare(h1, h3)
+		assert.InDelta(t, 0.4, result, 0.0001)
+
+		result, _ = ChiSquare(disjoint1, disjoint2)
+		assert.InDelta(t, 2.0, result, 0.0001)
+	})
+
+	t.Run("intersection", func(t *testing.T) {
+		result, err := Intersection(h1, h2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.0, result, 0.0001)
+
+		result, _ = Intersection(h1, h3)
+		assert.InDelta(t, 0.6, result, 0.0001)
+
+		result, _ = Intersection(disjoint1, disjoint2)
+		assert.InDelta(t, 0.0, result, 0.0001)
+	})
+
+	t.Run("bhattacharyya and hellinger", func(t *testing.T) {
+		result, err := Bhattacharyya(h1, h2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.0, result, 0.0001)
+
+		// BC = 2 * (sqrt(0.04) + sqrt(0.06)) = 0.8899
+		result, _ = Bhattacharyya(h1, h3)
+		assert.InDelta(t, 0.1166, result, 0.0001)
+		result, _ = Hellinger(h1, h3)
+		assert.InDelta(t, 0.3318, result, 0.0001)
+
+		result, _ = Bhattacharyya(disjoint1, disjoint2)
+		assert.True(t, math.IsInf(result, 1))
+		result, _ = Hellinger(disjoint1, disjoint2)
+		assert.InDelta(t, 1.0, result, 0.0001)
+	})
+
+	t.Run("kullback leibler", func(t *testing.T) {
+		result, err := KullbackLeibler(h1, h2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.0, result, 0.0001)
+
+		result, _ = KullbackLeibler(NewHistogram([]int{1, 1}), NewHistogram([]int{1, 3}))
+		assert.InDelta(t, 0.2075, result, 0.0001)
+
+		result, _ = KullbackLeibler(NewHistogram([]int{1, 1}), NewHistogram([]int{0, 3}))
+		assert.True(t, math.IsInf(result, 1))
+	})
+
+	t.Run("jensen shannon", func(t *testing.T) {
+		result, err := JensenShannon(h1, h2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.0, result, 0.0001)
+
+		result, _ = JensenShannon(disjoint1, disjoint2)
+		assert.InDelta(t, 1.0, result, 0.0001)
+
+		ab, _ := JensenShannon(h1, h3)
+		ba, _ := JensenShannon(h3, h1)
+		assert.InDelta(t, ab, ba, 0.0001)
+	})
+
+	t.Run("earth movers", func(t *testing.T) {
+		result, err := EarthMovers(h1, h2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.0, result, 0.0001)
+
+		result, _ = EarthMovers(disjoint1, disjoint2)
+		assert.InDelta(t, 3.0, result, 0.0001)
+
+		result, _ = EarthMovers(h1, h3)
+		assert.InDelta(t, 1.0, result, 0.0001)
+	})
+
+	t.Run("mismatched and empty histograms", func(t *testing.T) {
+		metrics := []func(a, b Histogram) (float64, error){
+			Correlation, ChiSquare, Intersection, Bhattacharyya,
+			Hellinger, KullbackLeibler, JensenShannon, EarthMovers,
+		}
+		for _, metric := range metrics {
+			_, err := metric(h1, short)
+			assert.ErrorIs(t, err, ErrBinMismatch)
+		}
+		for _, metric := range metrics[1:] {
+			_, err := metric(h1, empty)
+			assert.ErrorIs(t, err, ErrEmptyHistogram)
+		}
+	})
+}
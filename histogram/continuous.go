@@ -0,0 +1,121 @@
+package histogram
+
+import (
+	"github.com/jgardona/cmath/ranges"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// A histogram for continuous random values, with mean,
+// standard deviation, median, min, max and total count values.
+//
+// # Note
+//
+// The histogram's bins are spread uniformly over a float range, so
+// the first bin represents the range minimum and the last bin the range
+// maximum. All statistics are reported in the range units.
+type ContinuousHistogram struct {
+	values []int
+	bounds ranges.Range[float64]
+	mean   float64
+	stddev float64
+	median float64
+	min    float64
+	max    float64
+	total  int
+}
+
+// NewContinuousHistogram instantiates a continuous histogram given an int
+// array and the float range its bins are mapped to.
+func NewContinuousHistogram(histogram []int, bounds ranges.Range[float64]) ContinuousHistogram {
+	data := ContinuousHistogram{values: histogram, bounds: bounds}
+	data.Update()
+	return data
+}
+
+// Values returns the histogram array.
+func (h ContinuousHistogram) Values() []int {
+	return h.values
+}
+
+// Bounds returns the float range the histogram's bins are mapped to.
+func (h ContinuousHistogram) Bounds() ranges.Range[float64] {
+	return h.bounds
+}
+
+// Mean returns the histogram mean.
+func (h ContinuousHistogram) Mean() float64 {
+	return h.mean
+}
+
+// StdDev returns the histogram's standard deviation.
+func (h ContinuousHistogram) StdDev() float64 {
+	return h.stddev
+}
+
+// Median returns the histogram's median.
+func (h ContinuousHistogram) Median() float64 {
+	return h.median
+}
+
+// Min returns the histogram's minimum value.
+func (h ContinuousHistogram) Min() float64 {
+	return h.min
+}
+
+// Max returns the histogram's maximum value.
+func (h ContinuousHistogram) Max() float64 {
+	return h.max
+}
+
+// Total returns the histogram's total count.
+func (h ContinuousHistogram) Total() int {
+	return h.total
+}
+
+// Range returns a float range around the median, given the percent.
+func (h ContinuousHistogram) Range(percent float64) ranges.Range[float64] {
+	r := statistics.Range(h.values, percent)
+	return ranges.NewRange(h.binValue(float64(r.Min())), h.binValue(float64(r.Max())))
+}
+
+// binValue maps a bin index to its value inside the histogram's bounds.
+func (h ContinuousHistogram) binValue(index float64) float64 {
+	return h.bounds.Min() + index*h.binWidth()
+}
+
+// binWidth returns the distance between the values of two consecutive bins.
+func (h ContinuousHistogram) binWidth() float64 {
+	n := len(h.values)
+	if n < 2 {
+		return 0.0
+	}
+	return h.bounds.Length() / float64(n-1)
+}
+
+// Update updates all values in histogram. This function must only
+// be called to recalculate the histogram.
+//
+// An empty histogram reports the range minimum as its mean, median,
+// min and max values.
+func (h *ContinuousHistogram) Update() {
+	min, max := -1, 0
+	h.total = 0
+	for i, e := range h.values {
+		if e != 0 {
+			if min < 0 {
+				min = i
+			}
+			max = i
+		}
+		h.total += e
+	}
+	if min < 0 {
+		min = 0
+	}
+
+	h.min = h.binValue(float64(min))
+	h.max = h.binValue(float64(max))
+	h.mean = h.binValue(statistics.Mean(h.values))
+	h.stddev = statistics.StdDev(h.values) * h.binWidth()
+	h.median = h.binValue(float64(statistics.Median(h.values)))
+}
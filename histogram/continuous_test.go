@@ -0,0 +1,63 @@
+package histogram
+
+import (
+	"testing"
+
+	"github.com/jgardona/cmath/ranges"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContinuousHistogram(t *testing.T) {
+	histogram := []int{0, 0, 1, 3, 6, 8, 11, 0, 0, 0}
+	bounds := ranges.NewRange(-1.0, 0.8)
+
+	t.Run("the mean must be in range units", func(t *testing.T) {
+		h := NewContinuousHistogram(histogram, bounds)
+		assert.InDelta(t, -0.028, h.Mean(), 0.001)
+	})
+
+	t.Run("the stddev must be in range units", func(t *testing.T) {
+		h := NewContinuousHistogram(histogram, bounds)
+		assert.InDelta(t, 0.227, h.StdDev(), 0.001)
+	})
+
+	t.Run("median, min and max must be in range units", func(t *testing.T) {
+		h := NewContinuousHistogram(histogram, bounds)
+		assert.InDelta(t, 0.0, h.Median(), 0.001)
+		assert.InDelta(t, -0.6, h.Min(), 0.001)
+		assert.InDelta(t, 0.2, h.Max(), 0.001)
+	})
+
+	t.Run("total, values and bounds", func(t *testing.T) {
+		h := NewContinuousHistogram(histogram, bounds)
+		assert.Equal(t, 29, h.Total())
+		assert.Equal(t, histogram, h.Values())
+		assert.Equal(t, bounds, h.Bounds())
+	})
+
+	t.Run("range must return a float range", func(t *testing.T) {
+		h := NewContinuousHistogram(histogram, bounds)
+		r := h.Range(0.5)
+		assert.InDelta(t, -0.2, r.Min(), 0.001)
+		assert.InDelta(t, 0.2, r.Max(), 0.001)
+	})
+
+	t.Run("update must not accumulate values", func(t *testing.T) {
+		h := NewContinuousHistogram(histogram, bounds)
+		h.Update()
+		assert.Equal(t, 29, h.Total())
+		assert.InDelta(t, 0.2, h.Max(), 0.001)
+	})
+
+	t.Run("empty and single bin histograms", func(t *testing.T) {
+		h := NewContinuousHistogram([]int{0, 0, 0}, bounds)
+		assert.Equal(t, 0, h.Total())
+		assert.Equal(t, -1.0, h.Mean())
+		assert.Equal(t, -1.0, h.Min())
+		assert.Equal(t, -1.0, h.Max())
+
+		h = NewContinuousHistogram([]int{5}, bounds)
+		assert.Equal(t, -1.0, h.Mean())
+		assert.Equal(t, 0.0, h.StdDev())
+	})
+}
@@ -0,0 +1,138 @@
+package histogram
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var (
+	ErrEmptyHistogram = errors.New("histogram has no hits")
+	ErrBadPercent     = errors.New("percent must be in the interval [0, 1]")
+	ErrBadLUT         = errors.New("lookup table length must match the histogram length")
+)
+
+// Normalized returns the histogram as probabilities, i.e. each value divided
+// by the total count. An empty histogram returns only zeros.
+func (h Histogram) Normalized() []float64 {
+	result := make([]float64, len(h.values))
+	if h.total == 0 {
+		return result
+	}
+	total := float64(h.total)
+	for i, e := range h.values {
+		result[i] = float64(e) / total
+	}
+	return result
+}
+
+// CDF returns the cumulative distribution function, i.e. the probability of
+// a value being less than or equal to each index. An empty histogram
+// returns only zeros.
+func (h Histogram) CDF() []float64 {
+	result := make([]float64, len(h.values))
+	if h.total == 0 {
+		return result
+	}
+	total, sum := float64(h.total), 0
+	for i, e := range h.values {
+		sum += e
+		result[i] = float64(sum) / total
+	}
+	return result
+}
+
+// EqualizationLUT returns a lookup table which maps each index of the histogram
+// to a new index, so the remapped histogram has a flat cumulative distribution
+// over all the histogram's indexes.
+func (h Histogram) EqualizationLUT() ([]int, error) {
+	if h.total == 0 {
+		return nil, ErrEmptyHistogram
+	}
+
+	cdf := h.CDF()
+	levels := float64(len(h.values) - 1)
+	cdfmin := cdf[h.min]
+
+	lut := make([]int, len(h.values))
+	for i, e := range cdf {
+		if cdfmin == 1.0 {
+			lut[i] = i
+			continue
+		}
+		v := math.Round((e - cdfmin) / (1.0 - cdfmin) * levels)
+		lut[i] = int(math.Max(v, 0.0))
+	}
+	return lut, nil
+}
+
+// MatchLUT returns a lookup table which maps each index of the histogram to an
+// index of the target histogram, so the remapped histogram approximates the
+// target's distribution (histogram specification).
+func (h Histogram) MatchLUT(target Histogram) ([]int, error) {
+	if h.total == 0 || target.total == 0 {
+		return nil, ErrEmptyHistogram
+	}
+
+	source, dest := h.CDF(), target.CDF()
+	last := len(dest) - 1
+	lut := make([]int, len(source))
+	for i, e := range source {
+		// the first target index whose cumulative probability reaches the source's
+		j := sort.Search(len(dest), func(k int) bool { return dest[k] >= e-1e-12 })
+		lut[i] = min(j, last)
+	}
+	return lut, nil
+}
+
+// StretchLUT returns a contrast stretching lookup table. The range containing
+// the given percent of hits (see Range) is linearly stretched over all the
+// histogram's indexes, and the values outside the range are saturated.
+func (h Histogram) StretchLUT(percent float64) ([]int, error) {
+	if !(percent >= 0.0 && percent <= 1.0) {
+		return nil, ErrBadPercent
+	}
+	if h.total == 0 {
+		return nil, ErrEmptyHistogram
+	}
+
+	r := h.Range(percent)
+	low, high := float64(r.Min()), float64(r.Max())
+	levels := len(h.values) - 1
+
+	lut := make([]int, len(h.values))
+	for i := range lut {
+		switch {
+		case float64(i) <= low:
+			lut[i] = 0
+		case float64(i) >= high:
+			lut[i] = levels
+		default:
+			lut[i] = int(math.Round((float64(i) - low) / (high - low) * float64(levels)))
+		}
+	}
+	return lut, nil
+}
+
+// Remap applies a lookup table to the histogram, moving the hits of each
+// index i to the index lut[i]. The resulting histogram is as long as the
+// largest lut value plus one.
+func (h Histogram) Remap(lut []int) (Histogram, error) {
+	if len(lut) != len(h.values) {
+		return Histogram{}, ErrBadLUT
+	}
+
+	size := 0
+	for _, e := range lut {
+		if e < 0 {
+			return Histogram{}, ErrBadValue
+		}
+		size = max(size, e+1)
+	}
+
+	values := make([]int, size)
+	for i, e := range h.values {
+		values[lut[i]] += e
+	}
+	return NewHistogram(values), nil
+}
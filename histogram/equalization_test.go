@@ -0,0 +1,77 @@
+package histogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualization(t *testing.T) {
+	h := NewHistogram([]int{0, 0, 2, 4, 2, 0, 0, 0})
+
+	t.Run("normalized must return probabilities", func(t *testing.T) {
+		assert.Equal(t, []float64{0, 0, 0.25, 0.5, 0.25, 0, 0, 0}, h.Normalized())
+		assert.Equal(t, []float64{0, 0}, NewHistogram([]int{0, 0}).Normalized())
+	})
+
+	t.Run("cdf must accumulate probabilities", func(t *testing.T) {
+		assert.Equal(t, []float64{0, 0, 0.25, 0.75, 1, 1, 1, 1}, h.CDF())
+		assert.Equal(t, []float64{0, 0}, NewHistogram([]int{0, 0}).CDF())
+	})
+
+	t.Run("equalization must spread the hits", func(t *testing.T) {
+		lut, err := h.EqualizationLUT()
+		assert.Nil(t, err)
+		assert.Equal(t, []int{0, 0, 0, 5, 7, 7, 7, 7}, lut)
+
+		result, err := h.Remap(lut)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{2, 0, 0, 0, 0, 4, 0, 2}, result.Values())
+
+		// a single populated bin is left unchanged
+		lut, err = NewHistogram([]int{0, 3, 0}).EqualizationLUT()
+		assert.Nil(t, err)
+		assert.Equal(t, []int{0, 1, 2}, lut)
+
+		_, err = NewHistogram([]int{0, 0}).EqualizationLUT()
+		assert.ErrorIs(t, err, ErrEmptyHistogram)
+	})
+
+	t.Run("matching must follow the target distribution", func(t *testing.T) {
+		source := NewHistogram([]int{4, 4, 0, 0})
+		target := NewHistogram([]int{0, 0, 4, 4})
+		lut, err := source.MatchLUT(target)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{2, 3, 3, 3}, lut)
+
+		result, _ := source.Remap(lut)
+		assert.Equal(t, target.Values(), result.Values())
+
+		// matching a histogram with itself is the identity for populated bins
+		lut, _ = h.MatchLUT(h)
+		assert.Equal(t, []int{2, 3, 4}, lut[2:5])
+
+		_, err = source.MatchLUT(NewHistogram([]int{0}))
+		assert.ErrorIs(t, err, ErrEmptyHistogram)
+	})
+
+	t.Run("stretch must use the histogram range", func(t *testing.T) {
+		stretched := NewHistogram([]int{0, 0, 1, 3, 6, 8, 11, 0, 0, 0})
+		lut, err := stretched.StretchLUT(0.5)
+		assert.Nil(t, err)
+		// Range(0.5) is [4, 6]
+		assert.Equal(t, []int{0, 0, 0, 0, 0, 5, 9, 9, 9, 9}, lut)
+
+		_, err = stretched.StretchLUT(1.5)
+		assert.ErrorIs(t, err, ErrBadPercent)
+		_, err = NewHistogram([]int{0}).StretchLUT(0.5)
+		assert.ErrorIs(t, err, ErrEmptyHistogram)
+	})
+
+	t.Run("remap must validate the lookup table", func(t *testing.T) {
+		_, err := h.Remap([]int{0})
+		assert.ErrorIs(t, err, ErrBadLUT)
+		_, err = h.Remap([]int{0, 0, -1, 0, 0, 0, 0, 0})
+		assert.ErrorIs(t, err, ErrBadValue)
+	})
+}
@@ -8,10 +8,19 @@
 package histogram
 
 import (
+	"errors"
+	"math"
+
 	"github.com/jgardona/cmath/ranges"
 	"github.com/jgardona/cmath/statistics"
 )
 
+var (
+	ErrBadValue      = errors.New("histogram values must not be negative")
+	ErrBadCount      = errors.New("count must not be negative")
+	ErrNotEnoughHits = errors.New("cant remove more hits than the histogram has")
+)
+
 // A histogram for discrete random values, with mean,
 // standard deviation, median, min, max and total count values.
 type Histogram struct {
@@ -22,6 +31,13 @@ type Histogram struct {
 	min    int
 	max    int
 	total  int
+
+	// sum and squares are the sums of i*values[i] and i*i*values[i],
+	// used to update the mean and standard deviation incrementally.
+	sum     int
+	squares int
+	// stale is set when the median must be recalculated.
+	stale bool
 }
 
 // NewHistogram instantiates a histogram given an int array.
@@ -46,8 +62,13 @@ func (h Histogram) StdDev() float64 {
 	return h.stddev
 }
 
-// Median returns the histogram's median.
+// Median returns the histogram's median. After incremental updates the
+// median is recalculated on each request until the next call to Update,
+// so reading it never modifies the histogram.
 func (h Histogram) Median() int {
+	if h.stale {
+		return statistics.Median(h.values)
+	}
 	return h.median
 }
 
@@ -74,11 +95,11 @@ func (h Histogram) Range(percent float64) ranges.Range[int] {
 // Update updates all values in histogram. This function must only
 // be called to recalculate the histogram.
 func (h *Histogram) Update() {
-	var i int
 	var n int = len(h.values)
-	h.min = n
-	for i = 0; i < n; i++ {
-		if h.values[i] != 0 {
+	h.min, h.max = n, 0
+	h.total, h.sum, h.squares = 0, 0, 0
+	for i, e := range h.values {
+		if e != 0 {
 			if i > h.max {
 				h.max = i
 			}
@@ -87,11 +108,118 @@ func (h *Histogram) Update() {
 				h.min = i
 			}
 
-			h.total += h.values[i]
+			h.total += e
+			h.sum += i * e
+			h.squares += i * i * e
 		}
 	}
 
 	h.mean = statistics.Mean(h.values)
 	h.stddev = statistics.StdDev(h.values)
 	h.median = statistics.Median(h.values)
+	h.stale = false
+}
+
+// Add adds count hits to the given value, growing the histogram if the
+// value is beyond its last index. Mean, standard deviation, min, max and
+// total are updated in O(1), and the median is recalculated lazily.
+//
+// # Note
+//
+// The histogram array is updated in place, like the array passed to NewHistogram,
+// unless the histogram has to grow. Growing allocates a new array, which no
+// longer shares its values with the caller's array.
+func (h *Histogram) Add(value, count int) error {
+	if value < 0 {
+		return ErrBadValue
+	}
+	if count < 0 {
+		return ErrBadCount
+	}
+	if count == 0 {
+		return nil
+	}
+
+	if value >= len(h.values) {
+		grown := make([]int, value+1)
+		copy(grown, h.values)
+		h.values = grown
+	}
+
+	if h.total == 0 {
+		h.min, h.max = value, value
+	} else {
+		h.min = min(h.min, value)
+		h.max = max(h.max, value)
+	}
+
+	h.values[value] += count
+	h.accumulate(value, count)
+	return nil
+}
+
+// Remove removes count hits from the given value. Mean, standard deviation
+// and total are updated in O(1), and the median is recalculated lazily.
+// Min and max are searched again only if their bins become empty.
+func (h *Histogram) Remove(value, count int) error {
+	if value < 0 {
+		return ErrBadValue
+	}
+	if count < 0 {
+		return ErrBadCount
+	}
+	if count == 0 {
+		return nil
+	}
+	if value >= len(h.values) || h.values[value] < count {
+		return ErrNotEnoughHits
+	}
+
+	h.values[value] -= count
+	h.accumulate(value, -count)
+
+	if h.total == 0 {
+		h.min, h.max = len(h.values), 0
+	} else if h.values[value] == 0 {
+		for h.values[h.min] == 0 {
+			h.min++
+		}
+		for h.values[h.max] == 0 {
+			h.max--
+		}
+	}
+	return nil
+}
+
+// Merge adds all hits of other histogram to this histogram.
+func (h *Histogram) Merge(other Histogram) {
+	for i, e := range other.values {
+		if e > 0 {
+			_ = h.Add(i, e)
+		}
+	}
+}
+
+// Reset removes all hits from the histogram, keeping its length.
+func (h *Histogram) Reset() {
+	clear(h.values)
+	h.Update()
+}
+
+// accumulate updates the running sums and derived statistics, given
+// count hits added (or removed, if negative) to value.
+func (h *Histogram) accumulate(value, count int) {
+	h.total += count
+	h.sum += value * count
+	h.squares += value * value * count
+	h.stale = true
+
+	if h.total == 0 {
+		h.mean, h.stddev = 0.0, 0.0
+		return
+	}
+	total := float64(h.total)
+	h.mean = float64(h.sum) / total
+	variance := float64(h.squares)/total - h.mean*h.mean
+	h.stddev = math.Sqrt(math.Max(variance, 0.0))
 }
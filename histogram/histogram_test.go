@@ -52,6 +52,91 @@ func TestHistogram(t *testing.T) {
 	})
 }
 
+func TestIncrementalHistogram(t *testing.T) {
+	assertMatches := func(t *testing.T, expected, h Histogram) {
+		t.Helper()
+		assert.Equal(t, expected.Total(), h.Total())
+		assert.Equal(t, expected.Min(), h.Min())
+		assert.Equal(t, expected.Max(), h.Max())
+		assert.Equal(t, expected.Median(), h.Median())
+		assert.InDelta(t, expected.Mean(), h.Mean(), 0.0001)
+		assert.InDelta(t, expected.StdDev(), h.StdDev(), 0.0001)
+	}
+
+	t.Run("update must not accumulate total and max", func(t *testing.T) {
+		h := NewHistogram([]int{0, 0, 1, 3, 6, 8, 11, 0, 0, 0})
+		h.Update()
+		h.Update()
+		assert.Equal(t, 29, h.Total())
+		assert.Equal(t, 6, h.Max())
+		assert.Equal(t, 2, h.Min())
+	})
+
+	t.Run("add must update all statistics", func(t *testing.T) {
+		h := NewHistogram([]int{0, 0, 1, 3, 6, 8, 11, 0, 0, 0})
+		assert.Nil(t, h.Add(8, 20))
+		assert.Nil(t, h.Add(1, 2))
+		assert.Nil(t, h.Add(0, 0))
+		assertMatches(t, NewHistogram([]int{0, 2, 1, 3, 6, 8, 11, 0, 20, 0}), h)
+	})
+
+	t.Run("median must follow updates without modifying the histogram", func(t *testing.T) {
+		h := NewHistogram([]int{0, 0, 1, 3, 6, 8, 11, 0, 0, 0})
+		assert.Nil(t, h.Add(8, 20))
+		assert.True(t, h.stale)
+		assert.Equal(t, 6, h.Median())
+		assert.True(t, h.stale)
+
+		assert.Nil(t, h.Add(1, 40))
+		expected := NewHistogram([]int{0, 40, 1, 3, 6, 8, 11, 0, 20, 0})
+		assert.Equal(t, expected.Median(), h.Median())
+	})
+
+	t.Run("add must grow the histogram", func(t *testing.T) {
+		h := NewHistogram([]int{})
+		assert.Nil(t, h.Add(3, 2))
+		assert.Equal(t, []int{0, 0, 0, 2}, h.Values())
+		assertMatches(t, NewHistogram([]int{0, 0, 0, 2}), h)
+	})
+
+	t.Run("remove must update all statistics", func(t *testing.T) {
+		h := NewHistogram([]int{0, 0, 1, 3, 6, 8, 11, 0, 0, 0})
+		assert.Nil(t, h.Remove(2, 1))
+		assert.Nil(t, h.Remove(6, 11))
+		assert.Nil(t, h.Remove(4, 2))
+		assertMatches(t, NewHistogram([]int{0, 0, 0, 3, 4, 8, 0, 0, 0, 0}), h)
+
+		assert.Nil(t, h.Remove(3, 3))
+		assert.Nil(t, h.Remove(4, 4))
+		assert.Nil(t, h.Remove(5, 8))
+		assertMatches(t, NewHistogram(make([]int, 10)), h)
+	})
+
+	t.Run("invalid updates must return errors", func(t *testing.T) {
+		h := NewHistogram([]int{1, 2})
+		assert.ErrorIs(t, h.Add(-1, 1), ErrBadValue)
+		assert.ErrorIs(t, h.Add(1, -1), ErrBadCount)
+		assert.ErrorIs(t, h.Remove(1, 3), ErrNotEnoughHits)
+		assert.ErrorIs(t, h.Remove(5, 1), ErrNotEnoughHits)
+		assert.ErrorIs(t, h.Remove(-1, 1), ErrBadValue)
+		assertMatches(t, NewHistogram([]int{1, 2}), h)
+	})
+
+	t.Run("merge must add all hits", func(t *testing.T) {
+		h := NewHistogram([]int{1, 2, 3})
+		h.Merge(NewHistogram([]int{0, 1, 0, 0, 4}))
+		assert.Equal(t, []int{1, 3, 3, 0, 4}, h.Values())
+		assertMatches(t, NewHistogram([]int{1, 3, 3, 0, 4}), h)
+	})
+
+	t.Run("reset must remove all hits", func(t *testing.T) {
+		h := NewHistogram([]int{1, 2, 3})
+		h.Reset()
+		assert.Equal(t, []int{0, 0, 0}, h.Values())
+		assertMatches(t, NewHistogram([]int{0, 0, 0}), h)
+	})
+}
+
 func BenchmarkHistogram(b *testing.B) {
 	histogram := []int{0, 0, 1, 3, 6, 8, 11, 0, 0, 0}
 	h := NewHistogram(histogram)
@@ -86,6 +171,13 @@ func BenchmarkHistogram(b *testing.B) {
 		}
 	})
 
+	b.Run("benchmark add and remove functions", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = h.Add(4, 1)
+			_ = h.Remove(4, 1)
+		}
+	})
+
 	b.Run("benchmark NewHistogram/Update function", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			h := NewHistogram([]int{0, 0, 1, 2, 3, 2, 1, 0, 0})
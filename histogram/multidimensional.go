@@ -0,0 +1,250 @@
+package histogram
+
+import (
+	"errors"
+
+	"github.com/jgardona/cmath"
+	"github.com/jgardona/cmath/statistics"
+)
+
+var (
+	ErrRaggedData = errors.New("all histogram rows must have the same length")
+	ErrBadAxis    = errors.New("axis is out of the histogram dimensions")
+	ErrBadIndex   = errors.New("index is out of the histogram axis length")
+)
+
+// A two dimensional histogram for joint discrete random values, where
+// values[i][j] is the count of hits of the pair (i, j).
+type Histogram2D struct {
+	values [][]int
+	shape  [2]int
+	total  int
+}
+
+// NewHistogram2D instantiates a two dimensional histogram given a
+// rectangular int matrix.
+func NewHistogram2D(histogram [][]int) (Histogram2D, error) {
+	h := Histogram2D{values: histogram, shape: [2]int{len(histogram), 0}}
+	if len(histogram) > 0 {
+		h.shape[1] = len(histogram[0])
+	}
+	for _, row := range histogram {
+		if len(row) != h.shape[1] {
+			return Histogram2D{}, ErrRaggedData
+		}
+		h.total += cmath.Sum(row...)
+	}
+	return h, nil
+}
+
+// Values returns the histogram matrix.
+func (h Histogram2D) Values() [][]int {
+	return h.values
+}
+
+// Shape returns the length of each axis.
+func (h Histogram2D) Shape() [2]int {
+	return h.shape
+}
+
+// Total returns the histogram's total count.
+func (h Histogram2D) Total() int {
+	return h.total
+}
+
+// Marginal returns the marginal histogram of the given axis (0 or 1), i.e.
+// the hits of each index of the axis summed over the other axis.
+func (h Histogram2D) Marginal(axis int) (Histogram, error) {
+	if axis < 0 || axis > 1 {
+		return Histogram{}, ErrBadAxis
+	}
+
+	values := make([]int, h.shape[axis])
+	for i, row := range h.values {
+		for j, e := range row {
+			values[[2]int{i, j}[axis]] += e
+		}
+	}
+	return NewHistogram(values), nil
+}
+
+// Conditional returns the histogram of the other axis, given that the
+// specified axis (0 or 1) has the value index.
+func (h Histogram2D) Conditional(axis, index int) (Histogram, error) {
+	if axis < 0 || axis > 1 {
+		return Histogram{}, ErrBadAxis
+	}
+	if index < 0 || index >= h.shape[axis] {
+		return Histogram{}, ErrBadIndex
+	}
+
+	if axis == 0 {
+		values := make([]int, h.shape[1])
+		copy(values, h.values[index])
+		return NewHistogram(values), nil
+	}
+
+	values := make([]int, h.shape[0])
+	for i, row := range h.values {
+		values[i] = row[index]
+	}
+	return NewHistogram(values), nil
+}
+
+// JointEntropy calculates the entropy, in bits, of the joint distribution.
+func (h Histogram2D) JointEntropy() float64 {
+	return statistics.Entropy(flatten2D(h.values))
+}
+
+// MutualInformation calculates the mutual information, in bits, between both
+// axes, i.e. H(X) + H(Y) - H(X, Y).
+func (h Histogram2D) MutualInformation() float64 {
+	if h.total == 0 {
+		return 0.0
+	}
+	x, _ := h.Marginal(0)
+	y, _ := h.Marginal(1)
+	return statistics.Entropy(x.values) + statistics.Entropy(y.values) - h.JointEntropy()
+}
+
+// A three dimensional histogram for joint discrete random values, where
+// values[i][j][k] is the count of hits of the triple (i, j, k).
+type Histogram3D struct {
+	values [][][]int
+	shape  [3]int
+	total  int
+}
+
+// NewHistogram3D instantiates a three dimensional histogram given a
+// rectangular int cube.
+func NewHistogram3D(histogram [][][]int) (Histogram3D, error) {
+	h := Histogram3D{values: histogram, shape: [3]int{len(histogram), 0, 0}}
+	if len(histogram) > 0 {
+		h.shape[1] = len(histogram[0])
+		if len(histogram[0]) > 0 {
+			h.shape[2] = len(histogram[0][0])
+		}
+	}
+	for _, plane := range histogram {
+		if len(plane) != h.shape[1] {
+			return Histogram3D{}, ErrRaggedData
+		}
+		for _, row := range plane {
+			if len(row) != h.shape[2] {
+				return Histogram3D{}, ErrRaggedData
+			}
+			h.total += cmath.Sum(row...)
+		}
+	}
+	return h, nil
+}
+
+// Values returns the histogram cube.
+func (h Histogram3D) Values() [][][]int {
+	return h.values
+}
+
+// Shape returns the length of each axis.
+func (h Histogram3D) Shape() [3]int {
+	return h.shape
+}
+
+// Total returns the histogram's total count.
+func (h Histogram3D) Total() int {
+	return h.total
+}
+
+// Marginal returns the marginal histogram of the given axis (0, 1 or 2), i.e.
+// the hits of each index of the axis summed over the other axes.
+func (h Histogram3D) Marginal(axis int) (Histogram, error) {
+	if axis < 0 || axis > 2 {
+		return Histogram{}, ErrBadAxis
+	}
+
+	values := make([]int, h.shape[axis])
+	h.each(func(index [3]int, e int) {
+		values[index[axis]] += e
+	})
+	return NewHistogram(values), nil
+}
+
+// Marginal2D returns the joint histogram of two axes, summed over the
+// remaining axis. The first axis indexes the rows of the result.
+func (h Histogram3D) Marginal2D(a, b int) (Histogram2D, error) {
+	if a < 0 || a > 2 || b < 0 || b > 2 || a == b {
+		return Histogram2D{}, ErrBadAxis
+	}
+
+	values := make([][]int, h.shape[a])
+	for i := range values {
+		values[i] = make([]int, h.shape[b])
+	}
+	h.each(func(index [3]int, e int) {
+		values[index[a]][index[b]] += e
+	})
+	return NewHistogram2D(values)
+}
+
+// Conditional returns the joint histogram of the other two axes, in their
+// original order, given that the specified axis has the value index.
+func (h Histogram3D) Conditional(axis, index int) (Histogram2D, error) {
+	if axis < 0 || axis > 2 {
+		return Histogram2D{}, ErrBadAxis
+	}
+	if index < 0 || index >= h.shape[axis] {
+		return Histogram2D{}, ErrBadIndex
+	}
+
+	a, b := (axis+1)%3, (axis+2)%3
+	if a > b {
+		a, b = b, a
+	}
+	values := make([][]int, h.shape[a])
+	for i := range values {
+		values[i] = make([]int, h.shape[b])
+	}
+	h.each(func(i [3]int, e int) {
+		if i[axis] == index {
+			values[i[a]][i[b]] += e
+		}
+	})
+	return NewHistogram2D(values)
+}
+
+// JointEntropy calculates the entropy, in bits, of the joint distribution.
+func (h Histogram3D) JointEntropy() float64 {
+	values := make([]int, 0, h.shape[0]*h.shape[1]*h.shape[2])
+	for _, plane := range h.values {
+		values = append(values, flatten2D(plane)...)
+	}
+	return statistics.Entropy(values)
+}
+
+// MutualInformation calculates the mutual information, in bits, between two axes.
+func (h Histogram3D) MutualInformation(a, b int) (float64, error) {
+	joint, err := h.Marginal2D(a, b)
+	if err != nil {
+		return 0.0, err
+	}
+	return joint.MutualInformation(), nil
+}
+
+// each calls f for every cell of the histogram, with its index and value.
+func (h Histogram3D) each(f func(index [3]int, e int)) {
+	for i, plane := range h.values {
+		for j, row := range plane {
+			for k, e := range row {
+				f([3]int{i, j, k}, e)
+			}
+		}
+	}
+}
+
+// flatten2D concatenates all rows of a matrix.
+func flatten2D(values [][]int) []int {
+	var result []int
+	for _, row := range values {
+		result = append(result, row...)
+	}
+	return result
+}
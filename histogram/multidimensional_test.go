@@ -0,0 +1,131 @@
+package histogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram2D(t *testing.T) {
+	// x and y are independent
+	independent, err := NewHistogram2D([][]int{
+		{1, 2, 1},
+		{2, 4, 2},
+	})
+	assert.Nil(t, err)
+	// x and y are identical
+	identical, _ := NewHistogram2D([][]int{
+		{5, 0},
+		{0, 5},
+	})
+
+	t.Run("constructor must validate the shape", func(t *testing.T) {
+		_, err := NewHistogram2D([][]int{{1, 2}, {3}})
+		assert.ErrorIs(t, err, ErrRaggedData)
+		assert.Equal(t, [2]int{2, 3}, independent.Shape())
+		assert.Equal(t, 12, independent.Total())
+	})
+
+	t.Run("marginals must be histograms", func(t *testing.T) {
+		x, err := independent.Marginal(0)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{4, 8}, x.Values())
+		assert.Equal(t, 12, x.Total())
+
+		y, _ := independent.Marginal(1)
+		assert.Equal(t, []int{3, 6, 3}, y.Values())
+		assert.Equal(t, 1, y.Median())
+
+		_, err = independent.Marginal(2)
+		assert.ErrorIs(t, err, ErrBadAxis)
+	})
+
+	t.Run("conditionals must fix one axis", func(t *testing.T) {
+		h, err := independent.Conditional(0, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{2, 4, 2}, h.Values())
+
+		h, _ = independent.Conditional(1, 2)
+		assert.Equal(t, []int{1, 2}, h.Values())
+
+		_, err = independent.Conditional(0, 2)
+		assert.ErrorIs(t, err, ErrBadIndex)
+		_, err = independent.Conditional(-1, 0)
+		assert.ErrorIs(t, err, ErrBadAxis)
+	})
+
+	t.Run("joint entropy and mutual information", func(t *testing.T) {
+		assert.InDelta(t, 1.0, identical.JointEntropy(), 0.0001)
+		assert.InDelta(t, 1.0, identical.MutualInformation(), 0.0001)
+
+		// H(X, Y) = H(X) + H(Y) for independent variables
+		assert.InDelta(t, 2.4183, independent.JointEntropy(), 0.0001)
+		assert.InDelta(t, 0.0, independent.MutualInformation(), 0.0001)
+
+		empty, _ := NewHistogram2D([][]int{{0, 0}})
+		assert.Equal(t, 0.0, empty.MutualInformation())
+	})
+}
+
+func TestHistogram3D(t *testing.T) {
+	h, err := NewHistogram3D([][][]int{
+		{{1, 0}, {0, 2}, {1, 1}},
+		{{3, 0}, {0, 0}, {2, 0}},
+	})
+	assert.Nil(t, err)
+
+	t.Run("constructor must validate the shape", func(t *testing.T) {
+		_, err := NewHistogram3D([][][]int{{{1}, {2}}, {{3}}})
+		assert.ErrorIs(t, err, ErrRaggedData)
+		_, err = NewHistogram3D([][][]int{{{1}, {2, 3}}})
+		assert.ErrorIs(t, err, ErrRaggedData)
+		assert.Equal(t, [3]int{2, 3, 2}, h.Shape())
+		assert.Equal(t, 10, h.Total())
+	})
+
+	t.Run("marginals must sum the other axes", func(t *testing.T) {
+		m, err := h.Marginal(0)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{5, 5}, m.Values())
+		m, _ = h.Marginal(1)
+		assert.Equal(t, []int{4, 2, 4}, m.Values())
+		m, _ = h.Marginal(2)
+		assert.Equal(t, []int{7, 3}, m.Values())
+		_, err = h.Marginal(3)
+		assert.ErrorIs(t, err, ErrBadAxis)
+
+		joint, err := h.Marginal2D(0, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]int{{2, 3}, {5, 0}}, joint.Values())
+		joint, _ = h.Marginal2D(2, 0)
+		assert.Equal(t, [][]int{{2, 5}, {3, 0}}, joint.Values())
+		_, err = h.Marginal2D(1, 1)
+		assert.ErrorIs(t, err, ErrBadAxis)
+	})
+
+	t.Run("conditionals must fix one axis", func(t *testing.T) {
+		c, err := h.Conditional(0, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]int{{3, 0}, {0, 0}, {2, 0}}, c.Values())
+
+		c, _ = h.Conditional(1, 2)
+		assert.Equal(t, [][]int{{1, 1}, {2, 0}}, c.Values())
+
+		_, err = h.Conditional(2, 5)
+		assert.ErrorIs(t, err, ErrBadIndex)
+	})
+
+	t.Run("joint entropy and mutual information", func(t *testing.T) {
+		// the distribution has hits 1, 2, 1, 1, 3, 2 over ten
+		assert.InDelta(t, 2.4464, h.JointEntropy(), 0.0001)
+
+		joint, _ := h.Marginal2D(0, 2)
+		mi, err := h.MutualInformation(0, 2)
+		assert.Nil(t, err)
+		assert.InDelta(t, joint.MutualInformation(), mi, 0.0001)
+		assert.Greater(t, mi, 0.0)
+
+		_, err = h.MutualInformation(0, 0)
+		assert.ErrorIs(t, err, ErrBadAxis)
+	})
+}
@@ -0,0 +1,246 @@
+package histogram
+
+import (
+	"errors"
+	"math"
+
+	"github.com/jgardona/cmath/statistics"
+)
+
+// maxIterations limits the iterations of the iterative thresholding methods.
+const maxIterations = 1000
+
+var (
+	ErrBadClasses = errors.New("classes must be in the interval [2, histogram length]")
+)
+
+// The thresholding functions split the histogram's indexes in a background
+// class [0, t] and a foreground class [t+1, n-1], returning the index t.
+
+// Otsu calculates the threshold which maximizes the between class variance.
+func Otsu(h Histogram) (int, error) {
+	if h.total == 0 {
+		return 0, ErrEmptyHistogram
+	}
+
+	total, sum := float64(h.total), float64(h.sum)
+	var w0, sum0, best float64
+	threshold := h.min
+	for t := h.min; t < h.max; t++ {
+		w0 += float64(h.values[t])
+		sum0 += float64(t * h.values[t])
+		w1 := total - w0
+		if w0 == 0 || w1 == 0 {
+			continue
+		}
+		diff := sum0/w0 - (sum-sum0)/w1
+		variance := w0 * w1 * diff * diff
+		if variance > best {
+			best = variance
+			threshold = t
+		}
+	}
+	return threshold, nil
+}
+
+// Kapur calculates the maximum entropy threshold, which maximizes the sum
+// of the background and foreground entropies.
+func Kapur(h Histogram) (int, error) {
+	if h.total == 0 {
+		return 0, ErrEmptyHistogram
+	}
+
+	best := math.Inf(-1)
+	threshold := h.min
+	for t := h.min; t < h.max; t++ {
+		entropy := statistics.Entropy(h.values[:t+1]) + statistics.Entropy(h.values[t+1:])
+		if entropy > best {
+			best = entropy
+			threshold = t
+		}
+	}
+	return threshold, nil
+}
+
+// IsoData calculates the threshold with the iterative intermeans method. Starting
+// at the mean, the threshold is moved to the average of the background and
+// foreground means until it converges.
+func IsoData(h Histogram) (int, error) {
+	if h.total == 0 {
+		return 0, ErrEmptyHistogram
+	}
+
+	threshold := int(h.mean)
+	for i := 0; i < maxIterations; i++ {
+		var w0, sum0 int
+		for t := 0; t <= threshold; t++ {
+			w0 += h.values[t]
+			sum0 += t * h.values[t]
+		}
+		w1, sum1 := h.total-w0, h.sum-sum0
+		if w0 == 0 || w1 == 0 {
+			break
+		}
+
+		mean := (float64(sum0)/float64(w0) + float64(sum1)/float64(w1)) / 2.0
+		next := int(mean)
+		if next == threshold {
+			break
+		}
+		threshold = next
+	}
+	return threshold, nil
+}
+
+// Triangle calculates the threshold with the triangle method. A line is drawn
+// from the histogram's peak to the end of its longest tail, and the
+// threshold is the index with the largest distance to that line.
+func Triangle(h Histogram) (int, error) {
+	if h.total == 0 {
+		return 0, ErrEmptyHistogram
+	}
+
+	peak := statistics.Mode(h.values)
+	end := h.max
+	if peak-h.min > h.max-peak {
+		end = h.min
+	}
+
+	x1, y1 := float64(peak), float64(h.values[peak])
+	x2, y2 := float64(end), float64(h.values[end])
+	step := 1
+	if end < peak {
+		step = -1
+	}
+
+	best, threshold := -1.0, peak
+	for i := peak; i != end+step; i += step {
+		x, y := float64(i), float64(h.values[i])
+		distance := math.Abs((y2-y1)*x - (x2-x1)*y + x2*y1 - y2*x1)
+		if distance > best {
+			best = distance
+			threshold = i
+		}
+	}
+
+	// for a left tail the threshold index belongs to the foreground
+	if step < 0 && threshold > 0 {
+		threshold--
+	}
+	return threshold, nil
+}
+
+// Huang calculates the fuzzy thresholding of Huang and Wang, which minimizes
+// the fuzziness of the membership of each index to its class mean.
+func Huang(h Histogram) (int, error) {
+	if h.total == 0 {
+		return 0, ErrEmptyHistogram
+	}
+
+	first, last := h.min, h.max
+	if first == last {
+		return first, nil
+	}
+	term := 1.0 / float64(last-first)
+
+	n := len(h.values)
+	mu0 := make([]float64, n)
+	var sum, count float64
+	for i := first; i < n; i++ {
+		sum += float64(i * h.values[i])
+		count += float64(h.values[i])
+		mu0[i] = sum / count
+	}
+
+	mu1 := make([]float64, n)
+	sum, count = 0, 0
+	for i := last; i > 0; i-- {
+		sum += float64(i * h.values[i])
+		count += float64(h.values[i])
+		mu1[i-1] = sum / count
+	}
+
+	best := math.Inf(1)
+	threshold := first
+	for t := first; t < last; t++ {
+		entropy := 0.0
+		for i := first; i <= last; i++ {
+			mean := mu1[t]
+			if i <= t {
+				mean = mu0[t]
+			}
+			mu := 1.0 / (1.0 + term*math.Abs(float64(i)-mean))
+			if mu < 1e-06 || mu > 0.999999 {
+				continue
+			}
+			entropy += float64(h.values[i]) * (-mu*math.Log(mu) - (1.0-mu)*math.Log(1.0-mu))
+		}
+		if entropy < best {
+			best = entropy
+			threshold = t
+		}
+	}
+	return threshold, nil
+}
+
+// MultiOtsu calculates classes-1 thresholds which split the histogram in the
+// given number of classes, maximizing the between class variance.
+// The thresholds are returned in ascending order, each one being the last
+// index of its class.
+func MultiOtsu(h Histogram, classes int) ([]int, error) {
+	n := len(h.values)
+	if classes < 2 || classes > n {
+		return nil, ErrBadClasses
+	}
+	if h.total == 0 {
+		return nil, ErrEmptyHistogram
+	}
+
+	// prefix sums of hits and moments
+	p := make([]float64, n+1)
+	s := make([]float64, n+1)
+	for i, e := range h.values {
+		p[i+1] = p[i] + float64(e)
+		s[i+1] = s[i] + float64(i*e)
+	}
+	// score of the class [a, b], which is w*mean²
+	score := func(a, b int) float64 {
+		w := p[b+1] - p[a]
+		if w == 0 {
+			return 0
+		}
+		m := s[b+1] - s[a]
+		return m * m / w
+	}
+
+	// best[k][i] is the best score of k+1 classes covering [0, i]
+	best := make([][]float64, classes)
+	from := make([][]int, classes)
+	for k := range best {
+		best[k] = make([]float64, n)
+		from[k] = make([]int, n)
+	}
+	for i := 0; i < n; i++ {
+		best[0][i] = score(0, i)
+	}
+	for k := 1; k < classes; k++ {
+		for i := k; i < n; i++ {
+			best[k][i] = math.Inf(-1)
+			for j := k - 1; j < i; j++ {
+				v := best[k-1][j] + score(j+1, i)
+				if v > best[k][i] {
+					best[k][i] = v
+					from[k][i] = j
+				}
+			}
+		}
+	}
+
+	thresholds := make([]int, classes-1)
+	i := n - 1
+	for k := classes - 1; k > 0; k-- {
+		i = from[k][i]
+		thresholds[k-1] = i
+	}
+	return thresholds, nil
+}
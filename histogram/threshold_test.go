@@ -0,0 +1,92 @@
+package histogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThreshold(t *testing.T) {
+	// two well separated modes around 2 and 12
+	bimodal := NewHistogram([]int{0, 4, 10, 4, 0, 0, 0, 0, 0, 0, 0, 5, 12, 5, 0, 0})
+	empty := NewHistogram([]int{0, 0, 0})
+
+	t.Run("otsu must split the modes", func(t *testing.T) {
+		result, err := Otsu(bimodal)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, result)
+
+		result, err = Otsu(NewHistogram([]int{0, 3, 3, 0, 0, 9, 9}))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("kapur must split the modes", func(t *testing.T) {
+		result, err := Kapur(bimodal)
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, result, 3)
+		assert.Less(t, result, 11)
+	})
+
+	t.Run("isodata must converge between the modes", func(t *testing.T) {
+		result, err := IsoData(bimodal)
+		assert.Nil(t, err)
+		assert.Equal(t, 7, result)
+	})
+
+	t.Run("triangle must find the corner of the tail", func(t *testing.T) {
+		// a peak with a long right tail
+		h := NewHistogram([]int{0, 2, 20, 10, 5, 2, 1, 1, 1, 1, 0})
+		result, err := Triangle(h)
+		assert.Nil(t, err)
+		assert.Equal(t, 5, result)
+
+		// the mirrored histogram splits between the mirrored indexes
+		mirrored := NewHistogram([]int{0, 1, 1, 1, 1, 2, 5, 10, 20, 2, 0})
+		result, err = Triangle(mirrored)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, result)
+	})
+
+	t.Run("huang must split the modes", func(t *testing.T) {
+		result, err := Huang(bimodal)
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, result, 3)
+		assert.Less(t, result, 11)
+
+		result, err = Huang(NewHistogram([]int{0, 0, 7, 0}))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("multi otsu must split every mode", func(t *testing.T) {
+		trimodal := NewHistogram([]int{5, 9, 5, 0, 0, 4, 8, 4, 0, 0, 6, 9, 6})
+		result, err := MultiOtsu(trimodal, 3)
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.GreaterOrEqual(t, result[0], 2)
+		assert.Less(t, result[0], 5)
+		assert.GreaterOrEqual(t, result[1], 7)
+		assert.Less(t, result[1], 10)
+
+		// two classes must agree with otsu
+		result, err = MultiOtsu(bimodal, 2)
+		assert.Nil(t, err)
+		otsu, _ := Otsu(bimodal)
+		assert.Equal(t, []int{otsu}, result)
+
+		_, err = MultiOtsu(bimodal, 1)
+		assert.ErrorIs(t, err, ErrBadClasses)
+		_, err = MultiOtsu(bimodal, 17)
+		assert.ErrorIs(t, err, ErrBadClasses)
+		_, err = MultiOtsu(empty, 2)
+		assert.ErrorIs(t, err, ErrEmptyHistogram)
+	})
+
+	t.Run("empty histograms must return errors", func(t *testing.T) {
+		for _, method := range []func(Histogram) (int, error){Otsu, Kapur, IsoData, Triangle, Huang} {
+			_, err := method(empty)
+			assert.ErrorIs(t, err, ErrEmptyHistogram)
+		}
+	})
+}
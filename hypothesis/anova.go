@@ -0,0 +1,66 @@
+package hypothesis
+
+import (
+	"github.com/jgardona/cmath/distributions"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// ANOVAResult is the outcome of an analysis of variance. Its degrees of
+// freedom are the ones between groups.
+type ANOVAResult struct {
+	Result
+	withinDof float64
+}
+
+// WithinDegreesOfFreedom returns the degrees of freedom within groups.
+func (r ANOVAResult) WithinDegreesOfFreedom() float64 {
+	return r.withinDof
+}
+
+// ANOVA performs a one way analysis of variance, testing if all groups
+// have the same mean. The statistic follows a F distribution.
+func ANOVA(groups ...[]float64) (ANOVAResult, error) {
+	if len(groups) < 2 {
+		return ANOVAResult{}, ErrBadGroups
+	}
+
+	var total, n float64
+	for _, g := range groups {
+		if len(g) == 0 {
+			return ANOVAResult{}, ErrBadGroups
+		}
+		for _, e := range g {
+			total += e
+		}
+		n += float64(len(g))
+	}
+	k := float64(len(groups))
+	if n <= k {
+		return ANOVAResult{}, statistics.ErrTooFewSamples
+	}
+	grandMean := total / n
+
+	var between, within float64
+	for _, g := range groups {
+		mean := statistics.SampleMean(g)
+		d := mean - grandMean
+		between += float64(len(g)) * d * d
+		for _, e := range g {
+			within += (e - mean) * (e - mean)
+		}
+	}
+	if within == 0 {
+		return ANOVAResult{}, statistics.ErrNoVariance
+	}
+
+	dfb, dfw := k-1.0, n-k
+	statistic := (between / dfb) / (within / dfw)
+	f, err := distributions.NewF(dfb, dfw)
+	if err != nil {
+		return ANOVAResult{}, err
+	}
+	return ANOVAResult{
+		Result:    Result{statistic: statistic, dof: dfb, pValue: survival(f, statistic)},
+		withinDof: dfw,
+	}, nil
+}
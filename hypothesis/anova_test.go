@@ -0,0 +1,60 @@
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestANOVA(t *testing.T) {
+	g1 := []float64{6, 8, 4, 5, 3, 4}
+	g2 := []float64{8, 12, 9, 11, 6, 8}
+	g3 := []float64{13, 9, 11, 8, 7, 12}
+
+	t.Run("one way analysis of variance", func(t *testing.T) {
+		result, err := ANOVA(g1, g2, g3)
+		assert.Nil(t, err)
+		assert.InDelta(t, 9.264706, result.Statistic(), 0.000001)
+		assert.Equal(t, 2.0, result.DegreesOfFreedom())
+		assert.Equal(t, 15.0, result.WithinDegreesOfFreedom())
+		assert.InDelta(t, 0.002399, result.PValue(), 0.000001)
+		assert.True(t, result.Reject(0.01))
+	})
+
+	t.Run("extreme statistics must keep tiny p-values", func(t *testing.T) {
+		groups := make([][]float64, 3)
+		for k := range groups {
+			for i := 0; i <= 10; i++ {
+				groups[k] = append(groups[k], 100*float64(k)+0.1*float64(i))
+			}
+		}
+		result, err := ANOVA(groups...)
+		assert.Nil(t, err)
+		assert.Equal(t, 30.0, result.WithinDegreesOfFreedom())
+		// the F(2, d) survival function is (1 + 2x/d)^(-d/2)
+		expected := math.Pow(1+2*result.Statistic()/30, -15)
+		assert.Greater(t, result.PValue(), 0.0)
+		assert.InEpsilon(t, expected, result.PValue(), 0.000001)
+	})
+
+	t.Run("two groups must match the squared t statistic", func(t *testing.T) {
+		result, err := ANOVA(g1, g2)
+		assert.Nil(t, err)
+		tt, _ := TwoSampleTTest(g1, g2, TwoSided)
+		assert.InDelta(t, tt.Statistic()*tt.Statistic(), result.Statistic(), 0.000001)
+		assert.InDelta(t, tt.PValue(), result.PValue(), 0.000001)
+	})
+
+	t.Run("invalid groups", func(t *testing.T) {
+		_, err := ANOVA(g1)
+		assert.ErrorIs(t, err, ErrBadGroups)
+		_, err = ANOVA(g1, nil)
+		assert.ErrorIs(t, err, ErrBadGroups)
+		_, err = ANOVA([]float64{1}, []float64{2})
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+		_, err = ANOVA([]float64{1, 1}, []float64{2, 2})
+		assert.ErrorIs(t, err, statistics.ErrNoVariance)
+	})
+}
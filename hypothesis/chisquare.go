@@ -0,0 +1,113 @@
+package hypothesis
+
+import (
+	"math"
+
+	"github.com/jgardona/cmath/distributions"
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// chiSquareTest builds the result of a chi-square statistic with the given
+// degrees of freedom.
+func chiSquareTest(statistic, dof float64) (Result, error) {
+	if dof < 1 {
+		return Result{}, statistics.ErrTooFewSamples
+	}
+	d, err := distributions.NewChiSquare(dof)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{statistic: statistic, dof: dof, pValue: survival(d, statistic)}, nil
+}
+
+// ChiSquareGoodnessOfFit tests if the observed histogram follows the expected
+// frequencies. The expected frequencies are scaled to the histogram total,
+// so they can be given as counts, proportions or probabilities. Bins where
+// both the expected and observed frequencies are zero are ignored.
+func ChiSquareGoodnessOfFit(observed histogram.Histogram, expected []float64) (Result, error) {
+	values := observed.Values()
+	if len(values) != len(expected) {
+		return Result{}, histogram.ErrBinMismatch
+	}
+
+	var sum float64
+	for _, e := range expected {
+		if e < 0 || math.IsInf(e, 1) || math.IsNaN(e) {
+			return Result{}, ErrBadExpected
+		}
+		sum += e
+	}
+	if sum == 0 || observed.Total() == 0 {
+		return Result{}, statistics.ErrEmptySamples
+	}
+
+	scale := float64(observed.Total()) / sum
+	var statistic float64
+	bins := 0
+	for i, o := range values {
+		e := expected[i] * scale
+		if e == 0 {
+			if o > 0 {
+				return Result{}, ErrBadExpected
+			}
+			continue
+		}
+		d := float64(o) - e
+		statistic += d * d / e
+		bins++
+	}
+	return chiSquareTest(statistic, float64(bins-1))
+}
+
+// ChiSquareIndependence tests if the rows and columns of a contingency table
+// are independent. Each histogram is a row of the table and each bin a column.
+// Rows and columns without counts are ignored.
+func ChiSquareIndependence(table []histogram.Histogram) (Result, error) {
+	if len(table) == 0 {
+		return Result{}, statistics.ErrEmptySamples
+	}
+	columns := len(table[0].Values())
+	rowTotals := make([]float64, len(table))
+	columnTotals := make([]float64, columns)
+	var total float64
+	for i, row := range table {
+		values := row.Values()
+		if len(values) != columns {
+			return Result{}, histogram.ErrBinMismatch
+		}
+		for j, v := range values {
+			rowTotals[i] += float64(v)
+			columnTotals[j] += float64(v)
+		}
+		total += rowTotals[i]
+	}
+	if total == 0 {
+		return Result{}, statistics.ErrEmptySamples
+	}
+
+	var statistic float64
+	for i, row := range table {
+		for j, v := range row.Values() {
+			e := rowTotals[i] * columnTotals[j] / total
+			if e == 0 {
+				continue
+			}
+			d := float64(v) - e
+			statistic += d * d / e
+		}
+	}
+
+	rows, cols := 0, 0
+	for _, t := range rowTotals {
+		if t > 0 {
+			rows++
+		}
+	}
+	for _, t := range columnTotals {
+		if t > 0 {
+			cols++
+		}
+	}
+	return chiSquareTest(statistic, float64((rows-1)*(cols-1)))
+}
@@ -0,0 +1,70 @@
+package hypothesis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChiSquare(t *testing.T) {
+	t.Run("goodness of fit", func(t *testing.T) {
+		observed := histogram.NewHistogram([]int{18, 22, 20, 25, 15})
+		result, err := ChiSquareGoodnessOfFit(observed, []float64{1, 1, 1, 1, 1})
+		assert.Nil(t, err)
+		assert.InDelta(t, 2.9, result.Statistic(), 0.000001)
+		assert.Equal(t, 4.0, result.DegreesOfFreedom())
+		assert.InDelta(t, 0.574697, result.PValue(), 0.000001)
+
+		// extreme statistics must keep tiny p-values
+		extreme, err := ChiSquareGoodnessOfFit(histogram.NewHistogram([]int{500, 0, 0}), []float64{1, 1, 1})
+		assert.Nil(t, err)
+		assert.InDelta(t, 1000.0, extreme.Statistic(), 0.000001)
+		assert.InEpsilon(t, math.Exp(-500), extreme.PValue(), 0.000001)
+
+		// expected frequencies are scaled, empty bins are ignored
+		observed = histogram.NewHistogram([]int{18, 22, 20, 25, 15, 0})
+		scaled, err := ChiSquareGoodnessOfFit(observed, []float64{0.2, 0.2, 0.2, 0.2, 0.2, 0})
+		assert.Nil(t, err)
+		assert.Equal(t, result, scaled)
+
+		_, err = ChiSquareGoodnessOfFit(observed, []float64{1, 1})
+		assert.ErrorIs(t, err, histogram.ErrBinMismatch)
+		_, err = ChiSquareGoodnessOfFit(observed, []float64{1, 1, 1, 1, 0, 1})
+		assert.ErrorIs(t, err, ErrBadExpected)
+		_, err = ChiSquareGoodnessOfFit(observed, []float64{1, 1, 1, 1, -1, 1})
+		assert.ErrorIs(t, err, ErrBadExpected)
+		_, err = ChiSquareGoodnessOfFit(histogram.NewHistogram([]int{0, 0}), []float64{1, 1})
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+	})
+
+	t.Run("independence", func(t *testing.T) {
+		table := []histogram.Histogram{
+			histogram.NewHistogram([]int{10, 20, 30}),
+			histogram.NewHistogram([]int{20, 20, 20}),
+		}
+		result, err := ChiSquareIndependence(table)
+		assert.Nil(t, err)
+		assert.InDelta(t, 5.333333, result.Statistic(), 0.000001)
+		assert.Equal(t, 2.0, result.DegreesOfFreedom())
+		assert.InDelta(t, 0.069483, result.PValue(), 0.000001)
+
+		// empty columns do not change the result
+		padded := []histogram.Histogram{
+			histogram.NewHistogram([]int{10, 0, 20, 30}),
+			histogram.NewHistogram([]int{20, 0, 20, 20}),
+		}
+		result2, err := ChiSquareIndependence(padded)
+		assert.Nil(t, err)
+		assert.Equal(t, result, result2)
+
+		_, err = ChiSquareIndependence(nil)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+		_, err = ChiSquareIndependence([]histogram.Histogram{table[0], histogram.NewHistogram([]int{1})})
+		assert.ErrorIs(t, err, histogram.ErrBinMismatch)
+		_, err = ChiSquareIndependence(table[:1])
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+	})
+}
@@ -0,0 +1,85 @@
+// # Hypothesis
+//
+// This package contains parametric and non parametric hypothesis tests,
+// such as t-tests, chi-square tests, Kolmogorov-Smirnov, Mann-Whitney U
+// and one way ANOVA.
+package hypothesis
+
+import (
+	"errors"
+	"math"
+
+	"github.com/jgardona/cmath/distributions"
+)
+
+var (
+	ErrBadAlternative = errors.New("unknown alternative hypothesis")
+	ErrBadExpected    = errors.New("expected frequencies must not be negative and must be positive where counts were observed")
+	ErrBadGroups      = errors.New("at least two non empty groups are required")
+)
+
+// Alternative is the alternative hypothesis of a test.
+type Alternative int
+
+const (
+	// TwoSided tests if the statistic differs from the null hypothesis in any direction.
+	TwoSided Alternative = iota
+	// Less tests if the statistic is lesser than expected by the null hypothesis.
+	Less
+	// Greater tests if the statistic is greater than expected by the null hypothesis.
+	Greater
+)
+
+// Result is the outcome of a hypothesis test.
+type Result struct {
+	statistic float64
+	dof       float64
+	pValue    float64
+}
+
+// Statistic returns the test statistic.
+func (r Result) Statistic() float64 {
+	return r.statistic
+}
+
+// DegreesOfFreedom returns the degrees of freedom of the statistic's
+// distribution. Tests without degrees of freedom return zero.
+func (r Result) DegreesOfFreedom() float64 {
+	return r.dof
+}
+
+// PValue returns the probability of observing a statistic at least as
+// extreme as this one, given that the null hypothesis is true.
+func (r Result) PValue() float64 {
+	return r.pValue
+}
+
+// Reject checks if the null hypothesis is rejected at the significance level alpha.
+func (r Result) Reject(alpha float64) bool {
+	return r.pValue < alpha
+}
+
+// tailProbability calculates the p-value of a statistic with a symmetric
+// distribution, given its cumulative distribution function.
+func tailProbability(statistic float64, cdf func(float64) float64, alternative Alternative) (float64, error) {
+	switch alternative {
+	case TwoSided:
+		return min(1.0, 2.0*cdf(-math.Abs(statistic))), nil
+	case Less:
+		return cdf(statistic), nil
+	case Greater:
+		return cdf(-statistic), nil
+	default:
+		return 0.0, ErrBadAlternative
+	}
+}
+
+// survival calculates the upper tail probability of a distribution at x.
+// Distributions with a Survival method are evaluated by it, keeping the
+// precision of tiny p-values, and the others fall back to 1 - CDF(x).
+func survival(d distributions.Continuous, x float64) float64 {
+	if s, ok := d.(interface{ Survival(float64) float64 }); ok {
+		return s.Survival(x)
+	}
+	return 1.0 - d.CDF(x)
+}
@@ -0,0 +1,35 @@
+package hypothesis
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jgardona/cmath/distributions"
+	"github.com/stretchr/testify/assert"
+)
+
+// cdfOnly hides the Survival method of the wrapped distribution.
+type cdfOnly struct {
+	d distributions.Continuous
+}
+
+func (c cdfOnly) PDF(x float64) float64               { return c.d.PDF(x) }
+func (c cdfOnly) CDF(x float64) float64               { return c.d.CDF(x) }
+func (c cdfOnly) Quantile(p float64) (float64, error) { return c.d.Quantile(p) }
+func (c cdfOnly) Mean() float64                       { return c.d.Mean() }
+func (c cdfOnly) Variance() float64                   { return c.d.Variance() }
+func (c cdfOnly) Sample(r *rand.Rand) float64         { return c.d.Sample(r) }
+
+func TestSurvival(t *testing.T) {
+	d, _ := distributions.NewChiSquare(2)
+
+	t.Run("survival must use the distribution's survival function", func(t *testing.T) {
+		assert.Equal(t, d.Survival(1000), survival(d, 1000))
+		assert.Greater(t, survival(d, 1000), 0.0)
+	})
+
+	t.Run("survival must fall back to the cumulative function", func(t *testing.T) {
+		assert.InDelta(t, d.Survival(3), survival(cdfOnly{d}, 3), 0.000001)
+		assert.Equal(t, 0.0, survival(cdfOnly{d}, 1000))
+	})
+}
@@ -0,0 +1,134 @@
+package hypothesis
+
+import (
+	"math"
+	"slices"
+
+	"github.com/jgardona/cmath/distributions"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// kolmogorovQ evaluates the complementary cumulative distribution function
+// of the kolmogorov distribution.
+func kolmogorovQ(lambda float64) float64 {
+	if lambda <= 0.0 {
+		return 1.0
+	}
+	if lambda < 1.18 {
+		y := math.Exp(-math.Pi * math.Pi / (8.0 * lambda * lambda))
+		p := math.Sqrt(2.0*math.Pi) / lambda * (y + math.Pow(y, 9) + math.Pow(y, 25) + math.Pow(y, 49))
+		return max(0.0, 1.0-p)
+	}
+	x := math.Exp(-2.0 * lambda * lambda)
+	return min(1.0, 2.0*(x-math.Pow(x, 4)+math.Pow(x, 9)-math.Pow(x, 16)))
+}
+
+// kolmogorovPValue calculates the asymptotic p-value of the statistic d,
+// with stephens' correction for the effective number of samples.
+func kolmogorovPValue(d, n float64) float64 {
+	en := math.Sqrt(n)
+	return kolmogorovQ((en + 0.12 + 0.11/en) * d)
+}
+
+// KolmogorovSmirnov tests if the samples were drawn from the distribution d.
+// The statistic is the largest distance between the empirical and the
+// theoretical cumulative distribution functions.
+func KolmogorovSmirnov(samples []float64, d distributions.Continuous) (Result, error) {
+	if len(samples) == 0 {
+		return Result{}, statistics.ErrEmptySamples
+	}
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+
+	n := float64(len(sorted))
+	var statistic float64
+	for i, x := range sorted {
+		f := d.CDF(x)
+		statistic = max(statistic, f-float64(i)/n, float64(i+1)/n-f)
+	}
+	return Result{statistic: statistic, pValue: kolmogorovPValue(statistic, n)}, nil
+}
+
+// KolmogorovSmirnov2 tests if two samples were drawn from the same distribution.
+// The statistic is the largest distance between both empirical cumulative
+// distribution functions.
+func KolmogorovSmirnov2(a, b []float64) (Result, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return Result{}, statistics.ErrEmptySamples
+	}
+	sa, sb := slices.Clone(a), slices.Clone(b)
+	slices.Sort(sa)
+	slices.Sort(sb)
+
+	na, nb := float64(len(sa)), float64(len(sb))
+	var statistic float64
+	i, j := 0, 0
+	for i < len(sa) && j < len(sb) {
+		x := min(sa[i], sb[j])
+		for i < len(sa) && sa[i] == x {
+			i++
+		}
+		for j < len(sb) && sb[j] == x {
+			j++
+		}
+		statistic = max(statistic, math.Abs(float64(i)/na-float64(j)/nb))
+	}
+	return Result{statistic: statistic, pValue: kolmogorovPValue(statistic, na*nb/(na+nb))}, nil
+}
+
+// MannWhitneyU tests if the values of sample a tend to be lesser or greater
+// than the values of sample b. The statistic is the U value of sample a.
+//
+// # Note
+//
+// The p-value uses the normal approximation with tie and continuity
+// corrections, which is accurate for samples with more than 8 values.
+func MannWhitneyU(a, b []float64, alternative Alternative) (Result, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return Result{}, statistics.ErrEmptySamples
+	}
+
+	combined := append(slices.Clone(a), b...)
+	ranks := statistics.Ranks(combined)
+	var r1 float64
+	for _, r := range ranks[:len(a)] {
+		r1 += r
+	}
+
+	na, nb := float64(len(a)), float64(len(b))
+	n := na + nb
+	u := r1 - na*(na+1.0)/2.0
+	mu := na * nb / 2.0
+
+	// tie correction
+	slices.Sort(combined)
+	var ties float64
+	for i := 0; i < len(combined); {
+		j := i
+		for j < len(combined) && combined[j] == combined[i] {
+			j++
+		}
+		t := float64(j - i)
+		ties += t*t*t - t
+		i = j
+	}
+	sigma := math.Sqrt(na * nb / 12.0 * ((n + 1.0) - ties/(n*(n-1.0))))
+	if sigma == 0 || math.IsNaN(sigma) {
+		return Result{}, statistics.ErrNoVariance
+	}
+
+	normal, _ := distributions.NewNormal(0, 1)
+	var p float64
+	switch alternative {
+	case TwoSided:
+		z := max(0.0, math.Abs(u-mu)-0.5) / sigma
+		p = min(1.0, 2.0*normal.CDF(-z))
+	case Less:
+		p = normal.CDF((u - mu + 0.5) / sigma)
+	case Greater:
+		p = normal.CDF(-(u - mu - 0.5) / sigma)
+	default:
+		return Result{}, ErrBadAlternative
+	}
+	return Result{statistic: u, pValue: p}, nil
+}
@@ -0,0 +1,69 @@
+package hypothesis
+
+import (
+	"testing"
+
+	"github.com/jgardona/cmath/distributions"
+	"github.com/jgardona/cmath/random"
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonParametric(t *testing.T) {
+	a := []float64{12.1, 11.8, 12.6, 12.9, 12.3, 11.7, 12.4, 12.0}
+	b := []float64{11.2, 11.9, 11.5, 11.0, 11.8, 11.4, 11.6, 12.1, 10.9, 11.3}
+
+	t.Run("kolmogorov smirnov", func(t *testing.T) {
+		uniform, _ := distributions.NewUniform(0, 1)
+		result, err := KolmogorovSmirnov([]float64{0.7, 0.1, 0.9, 0.4}, uniform)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.2, result.Statistic(), 0.000001)
+		assert.InDelta(t, 0.991506, result.PValue(), 0.000001)
+
+		g, _ := random.NewGaussianGenerator(0, 1, 3)
+		samples := make([]float64, 500)
+		random.Fill(g, samples)
+		normal, _ := distributions.NewNormal(0, 1)
+		shifted, _ := distributions.NewNormal(0.5, 1)
+		result, _ = KolmogorovSmirnov(samples, normal)
+		assert.False(t, result.Reject(0.05))
+		result, _ = KolmogorovSmirnov(samples, shifted)
+		assert.True(t, result.Reject(0.001))
+
+		_, err = KolmogorovSmirnov(nil, normal)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+	})
+
+	t.Run("two sample kolmogorov smirnov", func(t *testing.T) {
+		result, err := KolmogorovSmirnov2(a, b)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.7, result.Statistic(), 0.000001)
+		assert.InDelta(t, 0.012242, result.PValue(), 0.000001)
+
+		result, _ = KolmogorovSmirnov2(a, a)
+		assert.Equal(t, 0.0, result.Statistic())
+		assert.Equal(t, 1.0, result.PValue())
+
+		_, err = KolmogorovSmirnov2(a, nil)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+	})
+
+	t.Run("mann whitney u", func(t *testing.T) {
+		result, err := MannWhitneyU(a, b, TwoSided)
+		assert.Nil(t, err)
+		assert.Equal(t, 73.0, result.Statistic())
+		assert.InDelta(t, 0.003844, result.PValue(), 0.000001)
+
+		result, _ = MannWhitneyU(a, b, Greater)
+		assert.InDelta(t, 0.001922, result.PValue(), 0.000001)
+		result, _ = MannWhitneyU(a, b, Less)
+		assert.Greater(t, result.PValue(), 0.99)
+
+		_, err = MannWhitneyU([]float64{1, 1}, []float64{1}, TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrNoVariance)
+		_, err = MannWhitneyU(a, nil, TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+		_, err = MannWhitneyU(a, b, Alternative(-1))
+		assert.ErrorIs(t, err, ErrBadAlternative)
+	})
+}
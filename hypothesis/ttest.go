@@ -0,0 +1,84 @@
+package hypothesis
+
+import (
+	"math"
+
+	"github.com/jgardona/cmath/distributions"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// tTest builds the result of a t statistic with the given degrees of freedom.
+func tTest(t, dof float64, alternative Alternative) (Result, error) {
+	d, err := distributions.NewStudentT(dof)
+	if err != nil {
+		return Result{}, err
+	}
+	p, err := tailProbability(t, d.CDF, alternative)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{statistic: t, dof: dof, pValue: p}, nil
+}
+
+// OneSampleTTest tests if the mean of the samples is equal to mu.
+func OneSampleTTest(samples []float64, mu float64, alternative Alternative) (Result, error) {
+	n := float64(len(samples))
+	if n < 2 {
+		return Result{}, statistics.ErrTooFewSamples
+	}
+	sd := statistics.SampleStdDev(samples)
+	if sd == 0 {
+		return Result{}, statistics.ErrNoVariance
+	}
+	t := (statistics.SampleMean(samples) - mu) / (sd / math.Sqrt(n))
+	return tTest(t, n-1.0, alternative)
+}
+
+// TwoSampleTTest tests if two independent samples have the same mean,
+// assuming both populations have the same variance.
+func TwoSampleTTest(a, b []float64, alternative Alternative) (Result, error) {
+	na, nb := float64(len(a)), float64(len(b))
+	if na < 2 || nb < 2 {
+		return Result{}, statistics.ErrTooFewSamples
+	}
+
+	dof := na + nb - 2.0
+	pooled := ((na-1.0)*statistics.SampleVariance(a) + (nb-1.0)*statistics.SampleVariance(b)) / dof
+	if pooled == 0 {
+		return Result{}, statistics.ErrNoVariance
+	}
+	se := math.Sqrt(pooled * (1.0/na + 1.0/nb))
+	t := (statistics.SampleMean(a) - statistics.SampleMean(b)) / se
+	return tTest(t, dof, alternative)
+}
+
+// WelchTTest tests if two independent samples have the same mean,
+// without assuming equal variances. The degrees of freedom are given by
+// the Welch-Satterthwaite equation.
+func WelchTTest(a, b []float64, alternative Alternative) (Result, error) {
+	na, nb := float64(len(a)), float64(len(b))
+	if na < 2 || nb < 2 {
+		return Result{}, statistics.ErrTooFewSamples
+	}
+
+	va := statistics.SampleVariance(a) / na
+	vb := statistics.SampleVariance(b) / nb
+	if va+vb == 0 {
+		return Result{}, statistics.ErrNoVariance
+	}
+	t := (statistics.SampleMean(a) - statistics.SampleMean(b)) / math.Sqrt(va+vb)
+	dof := (va + vb) * (va + vb) / (va*va/(na-1.0) + vb*vb/(nb-1.0))
+	return tTest(t, dof, alternative)
+}
+
+// PairedTTest tests if the mean difference between paired samples is zero.
+func PairedTTest(a, b []float64, alternative Alternative) (Result, error) {
+	if len(a) != len(b) {
+		return Result{}, statistics.ErrSeriesMismatch
+	}
+	diff := make([]float64, len(a))
+	for i := range a {
+		diff[i] = a[i] - b[i]
+	}
+	return OneSampleTTest(diff, 0.0, alternative)
+}
@@ -0,0 +1,72 @@
+package hypothesis
+
+import (
+	"testing"
+
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTests(t *testing.T) {
+	a := []float64{12.1, 11.8, 12.6, 12.9, 12.3, 11.7, 12.4, 12.0}
+	b := []float64{11.2, 11.9, 11.5, 11.0, 11.8, 11.4, 11.6, 12.1, 10.9, 11.3}
+
+	t.Run("one sample t-test", func(t *testing.T) {
+		result, err := OneSampleTTest(a, 12, TwoSided)
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.566699, result.Statistic(), 0.000001)
+		assert.Equal(t, 7.0, result.DegreesOfFreedom())
+		assert.InDelta(t, 0.161168, result.PValue(), 0.000001)
+		assert.False(t, result.Reject(0.05))
+
+		less, _ := OneSampleTTest(a, 12, Less)
+		greater, _ := OneSampleTTest(a, 12, Greater)
+		assert.InDelta(t, 1.0, less.PValue()+greater.PValue(), 0.000001)
+		assert.InDelta(t, result.PValue()/2, greater.PValue(), 0.000001)
+
+		_, err = OneSampleTTest(a[:1], 12, TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+		_, err = OneSampleTTest([]float64{1, 1, 1}, 12, TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrNoVariance)
+		_, err = OneSampleTTest(a, 12, Alternative(7))
+		assert.ErrorIs(t, err, ErrBadAlternative)
+	})
+
+	t.Run("two sample t-test", func(t *testing.T) {
+		result, err := TwoSampleTTest(a, b, TwoSided)
+		assert.Nil(t, err)
+		assert.InDelta(t, 4.013843, result.Statistic(), 0.000001)
+		assert.Equal(t, 16.0, result.DegreesOfFreedom())
+		assert.InDelta(t, 0.001002, result.PValue(), 0.000001)
+		assert.True(t, result.Reject(0.05))
+
+		result, _ = TwoSampleTTest(a, b, Greater)
+		assert.InDelta(t, 0.000501, result.PValue(), 0.000001)
+
+		_, err = TwoSampleTTest(a, b[:1], TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+	})
+
+	t.Run("welch t-test", func(t *testing.T) {
+		result, err := WelchTTest(a, b, TwoSided)
+		assert.Nil(t, err)
+		assert.InDelta(t, 3.993244, result.Statistic(), 0.000001)
+		assert.InDelta(t, 14.827993, result.DegreesOfFreedom(), 0.000001)
+		assert.InDelta(t, 0.001200, result.PValue(), 0.000001)
+
+		_, err = WelchTTest([]float64{1, 1}, []float64{2, 2}, TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrNoVariance)
+	})
+
+	t.Run("paired t-test", func(t *testing.T) {
+		after := []float64{11.9, 11.9, 12.2, 12.5, 12.4, 11.5, 12.0, 11.9}
+		result, err := PairedTTest(a, after, TwoSided)
+		assert.Nil(t, err)
+		assert.InDelta(t, 2.525177, result.Statistic(), 0.000001)
+		assert.Equal(t, 7.0, result.DegreesOfFreedom())
+		assert.InDelta(t, 0.039510, result.PValue(), 0.000001)
+
+		_, err = PairedTTest(a, b, TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrSeriesMismatch)
+	})
+}
@@ -0,0 +1,98 @@
+package kde
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jgardona/cmath/statistics"
+)
+
+// Bandwidth is a strategy to select the kernel bandwidth from samples and
+// their frequency weights, i.e. a weight of 3 counts as three equal samples.
+//
+// # Note
+//
+// The rules of thumb are optimal for gaussian kernels and normally
+// distributed samples.
+type Bandwidth func(samples, weights []float64) (float64, error)
+
+// Fixed is a bandwidth strategy which always selects h.
+func Fixed(h float64) Bandwidth {
+	return func(samples, weights []float64) (float64, error) {
+		if !(h > 0.0) || math.IsInf(h, 1) {
+			return 0.0, ErrBadBandwidth
+		}
+		return h, nil
+	}
+}
+
+// Silverman is the rule of thumb 0.9 min(σ, IQR/1.34) n^(-1/5), which is
+// robust to outliers and multimodal samples.
+func Silverman(samples, weights []float64) (float64, error) {
+	n, sd, err := spread(samples, weights)
+	if err != nil {
+		return 0.0, err
+	}
+	iqr := weightedQuantile(samples, weights, n, 0.75) - weightedQuantile(samples, weights, n, 0.25)
+	if iqr > 0.0 {
+		sd = min(sd, iqr/1.34)
+	}
+	return 0.9 * sd * math.Pow(n, -0.2), nil
+}
+
+// Scott is the rule of thumb 1.06σn^(-1/5).
+func Scott(samples, weights []float64) (float64, error) {
+	n, sd, err := spread(samples, weights)
+	if err != nil {
+		return 0.0, err
+	}
+	return 1.06 * sd * math.Pow(n, -0.2), nil
+}
+
+// spread returns the total weight and the weighted sample standard deviation.
+func spread(samples, weights []float64) (float64, float64, error) {
+	mean, err := statistics.WeightedMean(samples, weights)
+	if err != nil {
+		return 0.0, 0.0, err
+	}
+
+	var n, sum float64
+	for i, e := range samples {
+		n += weights[i]
+		sum += weights[i] * (e - mean) * (e - mean)
+	}
+	if n <= 1.0 {
+		return 0.0, 0.0, statistics.ErrTooFewSamples
+	}
+	if sum == 0.0 {
+		return 0.0, 0.0, statistics.ErrNoVariance
+	}
+	return n, math.Sqrt(sum / (n - 1.0)), nil
+}
+
+// weightedQuantile calculates the p quantile of samples with frequency
+// weights, interpolating linearly as if the samples were repeated.
+func weightedQuantile(samples, weights []float64, total, p float64) float64 {
+	order := make([]int, len(samples))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return samples[order[a]] < samples[order[b]] })
+
+	// at returns the sample at the rank r of the repeated samples.
+	at := func(r float64) float64 {
+		var cumulative float64
+		for _, i := range order {
+			cumulative += weights[i]
+			if cumulative > r {
+				return samples[i]
+			}
+		}
+		return samples[order[len(order)-1]]
+	}
+
+	h := (total - 1.0) * p
+	lo := math.Floor(h)
+	a := at(lo)
+	return a + (h-lo)*(at(lo+1.0)-a)
+}
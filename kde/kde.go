@@ -0,0 +1,126 @@
+// # KDE
+//
+// This package contains univariate and bivariate kernel density estimators,
+// which smooth samples or histograms into continuous densities.
+package kde
+
+import (
+	"errors"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/ranges"
+	"github.com/jgardona/cmath/statistics"
+)
+
+var (
+	ErrBadBandwidth = errors.New("bandwidth must be a positive number")
+	ErrBadGridSize  = errors.New("grid must have at least two points in each axis")
+)
+
+// KDE is an univariate kernel density estimator.
+type KDE struct {
+	samples   []float64
+	weights   []float64
+	total     float64
+	kernel    Kernel
+	bandwidth float64
+}
+
+// NewKDE instantiates a density estimator given the samples, the kernel
+// and the bandwidth selection strategy.
+func NewKDE(samples []float64, kernel Kernel, bandwidth Bandwidth) (KDE, error) {
+	weights := make([]float64, len(samples))
+	for i := range weights {
+		weights[i] = 1.0
+	}
+	return NewWeightedKDE(samples, weights, kernel, bandwidth)
+}
+
+// NewWeightedKDE instantiates a density estimator where each sample
+// contributes proportionally to its weight.
+func NewWeightedKDE(samples, weights []float64, kernel Kernel, bandwidth Bandwidth) (KDE, error) {
+	total, err := validateWeights(len(samples), weights)
+	if err != nil {
+		return KDE{}, err
+	}
+	h, err := bandwidth(samples, weights)
+	if err != nil {
+		return KDE{}, err
+	}
+	return KDE{samples: samples, weights: weights, total: total, kernel: kernel, bandwidth: h}, nil
+}
+
+// NewKDEFromHistogram instantiates a density estimator from a histogram,
+// where each bin is a sample weighted by its count.
+func NewKDEFromHistogram(h histogram.Histogram, kernel Kernel, bandwidth Bandwidth) (KDE, error) {
+	var samples, weights []float64
+	for v, count := range h.Values() {
+		if count > 0 {
+			samples = append(samples, float64(v))
+			weights = append(weights, float64(count))
+		}
+	}
+	return NewWeightedKDE(samples, weights, kernel, bandwidth)
+}
+
+// validateWeights checks the weights and returns their sum.
+func validateWeights(n int, weights []float64) (float64, error) {
+	if n == 0 {
+		return 0.0, statistics.ErrEmptySamples
+	}
+	if len(weights) != n {
+		return 0.0, statistics.ErrLengthMismatch
+	}
+	var total float64
+	for _, w := range weights {
+		if w < 0.0 {
+			return 0.0, statistics.ErrBadWeights
+		}
+		total += w
+	}
+	if total == 0.0 {
+		return 0.0, statistics.ErrBadWeights
+	}
+	return total, nil
+}
+
+// Bandwidth returns the selected bandwidth.
+func (k KDE) Bandwidth() float64 {
+	return k.bandwidth
+}
+
+// Evaluate estimates the density at x.
+func (k KDE) Evaluate(x float64) float64 {
+	var sum float64
+	for i, e := range k.samples {
+		sum += k.weights[i] * k.kernel((x-e)/k.bandwidth)
+	}
+	return sum / (k.total * k.bandwidth)
+}
+
+// EvaluateGrid estimates the density at n evenly spaced points, including
+// the bounds. Returns the points and their densities.
+func (k KDE) EvaluateGrid(bounds ranges.Range[float64], n int) ([]float64, []float64, error) {
+	xs, err := grid(bounds, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	densities := make([]float64, n)
+	for i, x := range xs {
+		densities[i] = k.Evaluate(x)
+	}
+	return xs, densities, nil
+}
+
+// grid returns n evenly spaced points, including the bounds.
+func grid(bounds ranges.Range[float64], n int) ([]float64, error) {
+	if n < 2 {
+		return nil, ErrBadGridSize
+	}
+	xs := make([]float64, n)
+	step := bounds.Length() / float64(n-1)
+	for i := range xs {
+		xs[i] = bounds.Min() + float64(i)*step
+	}
+	return xs, nil
+}
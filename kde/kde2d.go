@@ -0,0 +1,103 @@
+package kde
+
+import (
+	"github.com/jgardona/cmath/constraints"
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/points"
+	"github.com/jgardona/cmath/ranges"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// KDE2D is a bivariate kernel density estimator, using the product of the
+// kernel along each axis.
+type KDE2D struct {
+	x, y       []float64
+	weights    []float64
+	total      float64
+	kernel     Kernel
+	bandwidthX float64
+	bandwidthY float64
+}
+
+// NewKDE2D instantiates a bivariate density estimator given the points,
+// the kernel and the bandwidth selection strategy, which is applied to
+// each axis independently.
+func NewKDE2D[T constraints.Numbers](pts []points.Point[T], kernel Kernel, bandwidth Bandwidth) (KDE2D, error) {
+	x, y := statistics.Coordinates(pts)
+	weights := make([]float64, len(pts))
+	for i := range weights {
+		weights[i] = 1.0
+	}
+	return newKDE2D(x, y, weights, kernel, bandwidth)
+}
+
+// NewKDE2DFromHistogram instantiates a bivariate density estimator from a
+// two dimensional histogram, where each bin (i, j) is the point (i, j)
+// weighted by its count.
+func NewKDE2DFromHistogram(h histogram.Histogram2D, kernel Kernel, bandwidth Bandwidth) (KDE2D, error) {
+	var x, y, weights []float64
+	for i, row := range h.Values() {
+		for j, count := range row {
+			if count > 0 {
+				x = append(x, float64(i))
+				y = append(y, float64(j))
+				weights = append(weights, float64(count))
+			}
+		}
+	}
+	return newKDE2D(x, y, weights, kernel, bandwidth)
+}
+
+// newKDE2D selects the bandwidths and instantiates the estimator.
+func newKDE2D(x, y, weights []float64, kernel Kernel, bandwidth Bandwidth) (KDE2D, error) {
+	total, err := validateWeights(len(x), weights)
+	if err != nil {
+		return KDE2D{}, err
+	}
+	hx, err := bandwidth(x, weights)
+	if err != nil {
+		return KDE2D{}, err
+	}
+	hy, err := bandwidth(y, weights)
+	if err != nil {
+		return KDE2D{}, err
+	}
+	return KDE2D{x: x, y: y, weights: weights, total: total, kernel: kernel, bandwidthX: hx, bandwidthY: hy}, nil
+}
+
+// Bandwidth returns the selected bandwidths along the x and y axes.
+func (k KDE2D) Bandwidth() (float64, float64) {
+	return k.bandwidthX, k.bandwidthY
+}
+
+// Evaluate estimates the density at (x, y).
+func (k KDE2D) Evaluate(x, y float64) float64 {
+	var sum float64
+	for i := range k.x {
+		sum += k.weights[i] * k.kernel((x-k.x[i])/k.bandwidthX) * k.kernel((y-k.y[i])/k.bandwidthY)
+	}
+	return sum / (k.total * k.bandwidthX * k.bandwidthY)
+}
+
+// EvaluateGrid estimates the density on a nx by ny grid of evenly spaced
+// points, including the bounds. Returns the points along each axis and the
+// densities, where densities[i][j] is the density at (xs[i], ys[j]).
+func (k KDE2D) EvaluateGrid(boundsX, boundsY ranges.Range[float64], nx, ny int) ([]float64, []float64, [][]float64, error) {
+	xs, err := grid(boundsX, nx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ys, err := grid(boundsY, ny)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	densities := make([][]float64, nx)
+	for i, x := range xs {
+		densities[i] = make([]float64, ny)
+		for j, y := range ys {
+			densities[i][j] = k.Evaluate(x, y)
+		}
+	}
+	return xs, ys, densities, nil
+}
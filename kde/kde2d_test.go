@@ -0,0 +1,80 @@
+package kde
+
+import (
+	"testing"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/points"
+	"github.com/jgardona/cmath/ranges"
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKDE2D(t *testing.T) {
+	pts := []points.Point[int]{
+		points.NewPoint(0, 1), points.NewPoint(1, 1), points.NewPoint(1, 1),
+		points.NewPoint(1, 2), points.NewPoint(2, 0), points.NewPoint(2, 2),
+	}
+
+	t.Run("density must integrate to one", func(t *testing.T) {
+		k, err := NewKDE2D(pts, Gaussian, Scott)
+		assert.Nil(t, err)
+
+		bounds := ranges.NewRange(-6.0, 8.0)
+		xs, ys, densities, err := k.EvaluateGrid(bounds, bounds, 141, 141)
+		assert.Nil(t, err)
+		assert.Len(t, densities, 141)
+		assert.Len(t, densities[0], 141)
+
+		var volume float64
+		cell := (xs[1] - xs[0]) * (ys[1] - ys[0])
+		for i := range densities {
+			for j := range densities[i] {
+				volume += densities[i][j] * cell
+			}
+		}
+		assert.InDelta(t, 1.0, volume, 0.001)
+		assert.Equal(t, k.Evaluate(xs[70], ys[80]), densities[70][80])
+	})
+
+	t.Run("bandwidths are selected per axis", func(t *testing.T) {
+		k, _ := NewKDE2D(pts, Gaussian, Scott)
+		x, y := statistics.Coordinates(pts)
+		ones := []float64{1, 1, 1, 1, 1, 1}
+		hx, _ := Scott(x, ones)
+		hy, _ := Scott(y, ones)
+
+		bx, by := k.Bandwidth()
+		assert.Equal(t, hx, bx)
+		assert.Equal(t, hy, by)
+	})
+
+	t.Run("histograms are weighted points", func(t *testing.T) {
+		h, _ := histogram.NewHistogram2D([][]int{
+			{0, 1, 0},
+			{0, 2, 1},
+			{1, 0, 1},
+		})
+		expected, _ := NewKDE2D(pts, Epanechnikov, Silverman)
+		k, err := NewKDE2DFromHistogram(h, Epanechnikov, Silverman)
+		assert.Nil(t, err)
+		for _, p := range [][2]float64{{0, 0}, {1, 1.5}, {1.7, 0.2}} {
+			assert.InDelta(t, expected.Evaluate(p[0], p[1]), k.Evaluate(p[0], p[1]), 0.000001)
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, err := NewKDE2D([]points.Point[float64]{}, Gaussian, Scott)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+
+		// points over a vertical line have no spread along x
+		line := []points.Point[int]{points.NewPoint(1, 1), points.NewPoint(1, 2)}
+		_, err = NewKDE2D(line, Gaussian, Scott)
+		assert.ErrorIs(t, err, statistics.ErrNoVariance)
+
+		k, _ := NewKDE2D(pts, Gaussian, Scott)
+		bounds := ranges.NewRange(0.0, 1.0)
+		_, _, _, err = k.EvaluateGrid(bounds, bounds, 2, 0)
+		assert.ErrorIs(t, err, ErrBadGridSize)
+	})
+}
@@ -0,0 +1,160 @@
+package kde
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/random"
+	"github.com/jgardona/cmath/ranges"
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+// integrate calculates the area under the densities using the trapezoidal rule.
+func integrate(xs, densities []float64) float64 {
+	var area float64
+	for i := 1; i < len(xs); i++ {
+		area += (xs[i] - xs[i-1]) * (densities[i] + densities[i-1]) / 2.0
+	}
+	return area
+}
+
+func TestKernels(t *testing.T) {
+	kernels := map[string]Kernel{
+		"gaussian":     Gaussian,
+		"epanechnikov": Epanechnikov,
+		"triangular":   Triangular,
+		"uniform":      Uniform,
+	}
+
+	for name, kernel := range kernels {
+		t.Run(name+" kernel must be a symmetric density", func(t *testing.T) {
+			xs := make([]float64, 2001)
+			densities := make([]float64, len(xs))
+			for i := range xs {
+				xs[i] = -10.0 + float64(i)*0.01
+				densities[i] = kernel(xs[i])
+				assert.GreaterOrEqual(t, densities[i], 0.0)
+				assert.Equal(t, kernel(xs[i]), kernel(-xs[i]))
+			}
+			// the trapezoidal rule loses accuracy at the discontinuous edges
+			assert.InDelta(t, 1.0, integrate(xs, densities), 0.01)
+		})
+	}
+
+	t.Run("kernels values", func(t *testing.T) {
+		assert.InDelta(t, 0.398942, Gaussian(0), 0.000001)
+		assert.Equal(t, 0.75, Epanechnikov(0))
+		assert.Equal(t, 0.0, Epanechnikov(1.5))
+		assert.Equal(t, 0.5, Triangular(-0.5))
+		assert.Equal(t, 0.0, Uniform(-1.01))
+	})
+}
+
+func TestBandwidth(t *testing.T) {
+	samples := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	ones := []float64{1, 1, 1, 1, 1, 1, 1, 1}
+
+	t.Run("rules of thumb", func(t *testing.T) {
+		sd := statistics.SampleStdDev(samples)
+		iqr := statistics.SampleIQR(samples)
+		factor := math.Pow(8, -0.2)
+
+		h, err := Scott(samples, ones)
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.06*sd*factor, h, 0.000001)
+
+		h, err = Silverman(samples, ones)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.9*math.Min(sd, iqr/1.34)*factor, h, 0.000001)
+
+		h, err = Fixed(0.5)(samples, ones)
+		assert.Nil(t, err)
+		assert.Equal(t, 0.5, h)
+	})
+
+	t.Run("frequency weights must match repeated samples", func(t *testing.T) {
+		values := []float64{9, 2, 7, 4, 5}
+		weights := []float64{1, 1, 1, 3, 2}
+		for _, bandwidth := range []Bandwidth{Scott, Silverman} {
+			expected, _ := bandwidth(samples, ones)
+			h, err := bandwidth(values, weights)
+			assert.Nil(t, err)
+			assert.InDelta(t, expected, h, 0.000001)
+		}
+	})
+
+	t.Run("invalid samples", func(t *testing.T) {
+		_, err := Scott([]float64{1, 1}, []float64{1, 1})
+		assert.ErrorIs(t, err, statistics.ErrNoVariance)
+		_, err = Silverman([]float64{1}, []float64{1})
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+		_, err = Scott([]float64{1, 2}, []float64{1})
+		assert.ErrorIs(t, err, statistics.ErrLengthMismatch)
+		_, err = Fixed(0)(samples, ones)
+		assert.ErrorIs(t, err, ErrBadBandwidth)
+	})
+}
+
+func TestKDE(t *testing.T) {
+	samples := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	bounds := ranges.NewRange(-10.0, 20.0)
+
+	t.Run("density must integrate to one", func(t *testing.T) {
+		for _, kernel := range []Kernel{Gaussian, Epanechnikov, Triangular, Uniform} {
+			k, err := NewKDE(samples, kernel, Silverman)
+			assert.Nil(t, err)
+			xs, densities, err := k.EvaluateGrid(bounds, 3001)
+			assert.Nil(t, err)
+			assert.Equal(t, -10.0, xs[0])
+			assert.Equal(t, 20.0, xs[3000])
+			assert.InDelta(t, 1.0, integrate(xs, densities), 0.001)
+		}
+	})
+
+	t.Run("single sample is the scaled kernel", func(t *testing.T) {
+		k, err := NewKDE([]float64{3}, Epanechnikov, Fixed(2))
+		assert.Nil(t, err)
+		assert.Equal(t, 2.0, k.Bandwidth())
+		assert.InDelta(t, Epanechnikov(0.5)/2, k.Evaluate(4), 0.000001)
+		assert.Equal(t, 0.0, k.Evaluate(5.5))
+	})
+
+	t.Run("density must follow the samples distribution", func(t *testing.T) {
+		g, _ := random.NewGaussianGenerator(0, 1, 9)
+		normal := make([]float64, 5000)
+		random.Fill(g, normal)
+		k, err := NewKDE(normal, Gaussian, Scott)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.398942, k.Evaluate(0), 0.02)
+		assert.InDelta(t, 0.241971, k.Evaluate(1), 0.02)
+	})
+
+	t.Run("histograms are weighted samples", func(t *testing.T) {
+		h := histogram.NewHistogram([]int{0, 0, 1, 0, 3, 2, 0, 1, 0, 1})
+		expected, _ := NewKDE(samples, Gaussian, Silverman)
+		k, err := NewKDEFromHistogram(h, Gaussian, Silverman)
+		assert.Nil(t, err)
+		assert.InDelta(t, expected.Bandwidth(), k.Bandwidth(), 0.000001)
+		for _, x := range []float64{-1, 2, 4.5, 8} {
+			assert.InDelta(t, expected.Evaluate(x), k.Evaluate(x), 0.000001)
+		}
+
+		_, err = NewKDEFromHistogram(histogram.NewHistogram([]int{0, 0}), Gaussian, Silverman)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, err := NewKDE(nil, Gaussian, Scott)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+		_, err = NewWeightedKDE(samples, samples[:2], Gaussian, Scott)
+		assert.ErrorIs(t, err, statistics.ErrLengthMismatch)
+		_, err = NewWeightedKDE([]float64{1, 2}, []float64{1, -1}, Gaussian, Scott)
+		assert.ErrorIs(t, err, statistics.ErrBadWeights)
+
+		k, _ := NewKDE(samples, Gaussian, Scott)
+		_, _, err = k.EvaluateGrid(bounds, 1)
+		assert.ErrorIs(t, err, ErrBadGridSize)
+	})
+}
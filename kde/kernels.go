@@ -0,0 +1,38 @@
+package kde
+
+import "math"
+
+// Kernel is a symmetric probability density function used to smooth
+// each sample. Kernels are evaluated at u = (x - sample) / bandwidth.
+type Kernel func(u float64) float64
+
+// Gaussian is the standard normal density kernel, with unbounded support.
+func Gaussian(u float64) float64 {
+	return math.Exp(-0.5*u*u) / math.Sqrt(2.0*math.Pi)
+}
+
+// Epanechnikov is the parabolic kernel 3/4(1 - u²), with support [-1, 1].
+// It is optimal in the mean squared error sense.
+func Epanechnikov(u float64) float64 {
+	if math.Abs(u) > 1.0 {
+		return 0.0
+	}
+	return 0.75 * (1.0 - u*u)
+}
+
+// Triangular is the kernel 1 - |u|, with support [-1, 1].
+func Triangular(u float64) float64 {
+	a := math.Abs(u)
+	if a > 1.0 {
+		return 0.0
+	}
+	return 1.0 - a
+}
+
+// Uniform is the box kernel 1/2, with support [-1, 1].
+func Uniform(u float64) float64 {
+	if math.Abs(u) > 1.0 {
+		return 0.0
+	}
+	return 0.5
+}
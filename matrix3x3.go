@@ -0,0 +1,263 @@
+// # matrix3x3
+//
+// This package contains a 3x3 matrix structure used to transform 3D vectors.
+package cmath
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrSingularMatrix = errors.New("cant invert a singular matrix")
+)
+
+// # Matrix3x3
+//
+// A structure representing a 3x3 matrix, stored in row-major order.
+// The structure provides matrix operations and functions to build
+// rotation matrices, so it can be used to transform Vec3 values.
+type Matrix3x3 struct {
+	m [3][3]float64
+}
+
+// NewMatrix3x3 initializes a Matrix3x3 structure given its
+// elements in row-major order.
+func NewMatrix3x3(v00, v01, v02, v10, v11, v12, v20, v21, v22 float64) Matrix3x3 {
+	return Matrix3x3{[3][3]float64{
+		{v00, v01, v02},
+		{v10, v11, v12},
+		{v20, v21, v22},
+	}}
+}
+
+// NewMatrix3x3Identity returns the identity matrix.
+func NewMatrix3x3Identity() Matrix3x3 {
+	return NewMatrix3x3Diagonal(NewVec3(1, 1, 1))
+}
+
+// NewMatrix3x3Diagonal creates a diagonal matrix using the vector's
+// coordinates as diagonal elements.
+func NewMatrix3x3Diagonal(v Vec3) Matrix3x3 {
+	return NewMatrix3x3(
+		v.x, 0, 0,
+		0, v.y, 0,
+		0, 0, v.z,
+	)
+}
+
+// NewMatrix3x3FromRows creates a matrix from three row vectors.
+func NewMatrix3x3FromRows(r0, r1, r2 Vec3) Matrix3x3 {
+	return NewMatrix3x3(
+		r0.x, r0.y, r0.z,
+		r1.x, r1.y, r1.z,
+		r2.x, r2.y, r2.z,
+	)
+}
+
+// NewMatrix3x3FromColumns creates a matrix from three column vectors.
+func NewMatrix3x3FromColumns(c0, c1, c2 Vec3) Matrix3x3 {
+	return NewMatrix3x3(
+		c0.x, c1.x, c2.x,
+		c0.y, c1.y, c2.y,
+		c0.z, c1.z, c2.z,
+	)
+}
+
+// NewMatrix3x3RotationX creates a rotation matrix around the x axis.
+// The angle is given in radians.
+func NewMatrix3x3RotationX(radians float64) Matrix3x3 {
+	sin, cos := math.Sincos(radians)
+	return NewMatrix3x3(
+		1, 0, 0,
+		0, cos, -sin,
+		0, sin, cos,
+	)
+}
+
+// NewMatrix3x3RotationY creates a rotation matrix around the y axis.
+// The angle is given in radians.
+func NewMatrix3x3RotationY(radians float64) Matrix3x3 {
+	sin, cos := math.Sincos(radians)
+	return NewMatrix3x3(
+		cos, 0, sin,
+		0, 1, 0,
+		-sin, 0, cos,
+	)
+}
+
+// NewMatrix3x3RotationZ creates a rotation matrix around the z axis.
+// The angle is given in radians.
+func NewMatrix3x3RotationZ(radians float64) Matrix3x3 {
+	sin, cos := math.Sincos(radians)
+	return NewMatrix3x3(
+		cos, -sin, 0,
+		sin, cos, 0,
+		0, 0, 1,
+	)
+}
+
+// NewMatrix3x3FromYawPitchRoll creates a rotation matrix from yaw, pitch and roll
+// angles given in radians.
+//
+// # Note
+//
+// The result is the product RotationY(yaw) * RotationX(pitch) * RotationZ(roll),
+// i.e. the roll rotation is applied first and the yaw rotation last.
+func NewMatrix3x3FromYawPitchRoll(yaw, pitch, roll float64) Matrix3x3 {
+	ry := NewMatrix3x3RotationY(yaw)
+	rx := NewMatrix3x3RotationX(pitch)
+	rz := NewMatrix3x3RotationZ(roll)
+	return ry.Multiply(rx).Multiply(rz)
+}
+
+// At returns the element at the given row and column.
+func (m Matrix3x3) At(row, col int) float64 {
+	return m.m[row][col]
+}
+
+// Row returns the matrix's row as a vector.
+func (m Matrix3x3) Row(i int) Vec3 {
+	return NewVec3(m.m[i][0], m.m[i][1], m.m[i][2])
+}
+
+// Column returns the matrix's column as a vector.
+func (m Matrix3x3) Column(i int) Vec3 {
+	return NewVec3(m.m[0][i], m.m[1][i], m.m[2][i])
+}
+
+// AsArray returns the matrix elements as an array in row-major order.
+func (m Matrix3x3) AsArray() [9]float64 {
+	return [9]float64{
+		m.m[0][0], m.m[0][1], m.m[0][2],
+		m.m[1][0], m.m[1][1], m.m[1][2],
+		m.m[2][0], m.m[2][1], m.m[2][2],
+	}
+}
+
+// Sum implements matrix addition.
+func (m Matrix3x3) Sum(a Matrix3x3) Matrix3x3 {
+	var r Matrix3x3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r.m[i][j] = m.m[i][j] + a.m[i][j]
+		}
+	}
+	return r
+}
+
+// Subtract implements matrix subtraction.
+func (m Matrix3x3) Subtract(a Matrix3x3) Matrix3x3 {
+	var r Matrix3x3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r.m[i][j] = m.m[i][j] - a.m[i][j]
+		}
+	}
+	return r
+}
+
+// MulScalar implements scalar matrix multiplication.
+func (m Matrix3x3) MulScalar(scalar float64) Matrix3x3 {
+	var r Matrix3x3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r.m[i][j] = m.m[i][j] * scalar
+		}
+	}
+	return r
+}
+
+// Multiply implements matrix multiplication. The result is m * a.
+func (m Matrix3x3) Multiply(a Matrix3x3) Matrix3x3 {
+	var r Matrix3x3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r.m[i][j] = m.m[i][0]*a.m[0][j] + m.m[i][1]*a.m[1][j] + m.m[i][2]*a.m[2][j]
+		}
+	}
+	return r
+}
+
+// MulVec3 multiplies the matrix by a column vector. The result is m * v.
+func (m Matrix3x3) MulVec3(v Vec3) Vec3 {
+	return NewVec3(
+		m.m[0][0]*v.x+m.m[0][1]*v.y+m.m[0][2]*v.z,
+		m.m[1][0]*v.x+m.m[1][1]*v.y+m.m[1][2]*v.z,
+		m.m[2][0]*v.x+m.m[2][1]*v.y+m.m[2][2]*v.z,
+	)
+}
+
+// Transpose returns the transposed matrix.
+func (m Matrix3x3) Transpose() Matrix3x3 {
+	return NewMatrix3x3FromColumns(m.Row(0), m.Row(1), m.Row(2))
+}
+
+// Determinant calculates the matrix's determinant.
+func (m Matrix3x3) Determinant() float64 {
+	return m.m[0][0]*(m.m[1][1]*m.m[2][2]-m.m[1][2]*m.m[2][1]) -
+		m.m[0][1]*(m.m[1][0]*m.m[2][2]-m.m[1][2]*m.m[2][0]) +
+		m.m[0][2]*(m.m[1][0]*m.m[2][1]-m.m[1][1]*m.m[2][0])
+}
+
+// Adjugate calculates the adjugate matrix, i.e. the transpose of the
+// cofactor matrix.
+func (m Matrix3x3) Adjugate() Matrix3x3 {
+	a := m.m
+	return NewMatrix3x3(
+		a[1][1]*a[2][2]-a[1][2]*a[2][1],
+		a[0][2]*a[2][1]-a[0][1]*a[2][2],
+		a[0][1]*a[1][2]-a[0][2]*a[1][1],
+
+		a[1][2]*a[2][0]-a[1][0]*a[2][2],
+		a[0][0]*a[2][2]-a[0][2]*a[2][0],
+		a[0][2]*a[1][0]-a[0][0]*a[1][2],
+
+		a[1][0]*a[2][1]-a[1][1]*a[2][0],
+		a[0][1]*a[2][0]-a[0][0]*a[2][1],
+		a[0][0]*a[1][1]-a[0][1]*a[1][0],
+	)
+}
+
+// Inverse calculates the inverse matrix.
+// Returns ErrSingularMatrix if the matrix determinant is zero.
+func (m Matrix3x3) Inverse() (Matrix3x3, error) {
+	det := m.Determinant()
+	if det == 0.0 {
+		return Matrix3x3{}, ErrSingularMatrix
+	}
+	return m.Adjugate().MulScalar(1.0 / det), nil
+}
+
+// ExtractYawPitchRoll extracts the yaw, pitch and roll angles, in radians,
+// from a rotation matrix built with NewMatrix3x3FromYawPitchRoll.
+//
+// # Note
+//
+// When the pitch is ±π/2 (gimbal lock) yaw and roll rotate around the same
+// axis, so the roll is set to zero and the whole rotation goes to the yaw.
+func (m Matrix3x3) ExtractYawPitchRoll() (yaw, pitch, roll float64) {
+	// rounding errors of composed rotations may push the sine beyond ±1
+	sin := math.Max(-1.0, math.Min(1.0, -m.m[1][2]))
+	pitch = math.Asin(sin)
+
+	if math.Hypot(m.m[1][0], m.m[1][1]) < 1e-10 {
+		yaw = math.Atan2(sin*m.m[0][1], m.m[0][0])
+		return yaw, pitch, 0.0
+	}
+	yaw = math.Atan2(m.m[0][2], m.m[2][2])
+	roll = math.Atan2(m.m[1][0], m.m[1][1])
+	return yaw, pitch, roll
+}
+
+// Equals compares float numbers using epsilon.
+func (m Matrix3x3) Equals(a Matrix3x3, epsilon float64) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(m.m[i][j]-a.m[i][j]) >= epsilon {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package cmath
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatrix3x3(t *testing.T) {
+	m1 := NewMatrix3x3(
+		1, 2, 3,
+		0, 1, 4,
+		5, 6, 0,
+	)
+	m2 := NewMatrix3x3(
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9,
+	)
+	identity := NewMatrix3x3Identity()
+
+	t.Run("test from rows and columns", func(t *testing.T) {
+		rows := NewMatrix3x3FromRows(NewVec3(1, 2, 3), NewVec3(4, 5, 6), NewVec3(7, 8, 9))
+		assert.Equal(t, m2, rows)
+
+		columns := NewMatrix3x3FromColumns(NewVec3(1, 4, 7), NewVec3(2, 5, 8), NewVec3(3, 6, 9))
+		assert.Equal(t, m2, columns)
+
+		assert.Equal(t, NewVec3(4, 5, 6), m2.Row(1))
+		assert.Equal(t, NewVec3(3, 6, 9), m2.Column(2))
+		assert.Equal(t, 8.0, m2.At(2, 1))
+	})
+
+	t.Run("test asarray method", func(t *testing.T) {
+		assert.Equal(t, [9]float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, m2.AsArray())
+	})
+
+	t.Run("test sum and subtract methods", func(t *testing.T) {
+		result := m2.Sum(identity)
+		assert.Equal(t, NewMatrix3x3(2, 2, 3, 4, 6, 6, 7, 8, 10), result)
+		assert.Equal(t, m2, result.Subtract(identity))
+	})
+
+	t.Run("test multiply method", func(t *testing.T) {
+		result := m1.Multiply(m2)
+		assert.Equal(t, NewMatrix3x3(30, 36, 42, 32, 37, 42, 29, 40, 51), result)
+		assert.Equal(t, m1, m1.Multiply(identity))
+	})
+
+	t.Run("test mulvec3 method", func(t *testing.T) {
+		result := m2.MulVec3(NewVec3(1, 0, -1))
+		assert.Equal(t, NewVec3(-2, -2, -2), result)
+	})
+
+	t.Run("test transpose method", func(t *testing.T) {
+		result := m2.Transpose()
+		assert.Equal(t, NewMatrix3x3(1, 4, 7, 2, 5, 8, 3, 6, 9), result)
+	})
+
+	t.Run("test determinant method", func(t *testing.T) {
+		assert.InDelta(t, 1.0, m1.Determinant(), 0.001)
+		assert.InDelta(t, 0.0, m2.Determinant(), 0.001)
+	})
+
+	t.Run("test adjugate method", func(t *testing.T) {
+		result := m1.Adjugate()
+		assert.Equal(t, NewMatrix3x3(-24, 18, 5, 20, -15, -4, -5, 4, 1), result)
+	})
+
+	t.Run("test inverse method", func(t *testing.T) {
+		result, err := m1.Inverse()
+		assert.Nil(t, err)
+		assert.True(t, identity.Equals(m1.Multiply(result), 0.0001))
+
+		_, err = m2.Inverse()
+		assert.ErrorIs(t, err, ErrSingularMatrix)
+	})
+
+	t.Run("test rotation matrices", func(t *testing.T) {
+		v := NewVec3(1, 0, 0)
+		result := NewMatrix3x3RotationZ(math.Pi / 2).MulVec3(v)
+		assert.True(t, result.Equals(NewVec3(0, 1, 0), 0.0001))
+
+		result = NewMatrix3x3RotationY(math.Pi / 2).MulVec3(v)
+		assert.True(t, result.Equals(NewVec3(0, 0, -1), 0.0001))
+
+		result = NewMatrix3x3RotationX(math.Pi / 2).MulVec3(NewVec3(0, 1, 0))
+		assert.True(t, result.Equals(NewVec3(0, 0, 1), 0.0001))
+	})
+
+	t.Run("test yaw pitch roll round trip", func(t *testing.T) {
+		m := NewMatrix3x3FromYawPitchRoll(0.3, -0.5, 1.2)
+		yaw, pitch, roll := m.ExtractYawPitchRoll()
+		assert.InDelta(t, 0.3, yaw, 0.0001)
+		assert.InDelta(t, -0.5, pitch, 0.0001)
+		assert.InDelta(t, 1.2, roll, 0.0001)
+		assert.InDelta(t, 1.0, m.Determinant(), 0.0001)
+	})
+
+	t.Run("test yaw pitch roll at gimbal lock", func(t *testing.T) {
+		for _, pitch := range []float64{math.Pi / 2, -math.Pi / 2} {
+			m := NewMatrix3x3FromYawPitchRoll(0.3, pitch, 0.2)
+			yaw, resultPitch, roll := m.ExtractYawPitchRoll()
+			assert.InDelta(t, pitch, resultPitch, 0.0001)
+			assert.Equal(t, 0.0, roll)
+			assert.True(t, m.Equals(NewMatrix3x3FromYawPitchRoll(yaw, resultPitch, roll), 0.0001))
+		}
+
+		// rounding errors beyond ±1 must not produce NaN
+		m := NewMatrix3x3FromYawPitchRoll(0, math.Pi/2, 0)
+		m.m[1][2] = -1.0000000001
+		_, pitch, _ := m.ExtractYawPitchRoll()
+		assert.InDelta(t, math.Pi/2, pitch, 0.0001)
+	})
+}
@@ -0,0 +1,322 @@
+// # matrix4x4
+//
+// This package contains a 4x4 matrix structure used for homogeneous 3D transforms.
+package cmath
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrDegenerateView = errors.New("view or projection volume is degenerate")
+)
+
+// # Matrix4x4
+//
+// A structure representing a 4x4 matrix, stored in row-major order.
+// The matrix is meant to be multiplied by column vectors (m * v), so
+// transforms are combined from right to left: a.Multiply(b) applies b first.
+type Matrix4x4 struct {
+	m [4][4]float64
+}
+
+// NewMatrix4x4 initializes a Matrix4x4 structure given its
+// elements in row-major order.
+func NewMatrix4x4(
+	v00, v01, v02, v03,
+	v10, v11, v12, v13,
+	v20, v21, v22, v23,
+	v30, v31, v32, v33 float64) Matrix4x4 {
+	return Matrix4x4{[4][4]float64{
+		{v00, v01, v02, v03},
+		{v10, v11, v12, v13},
+		{v20, v21, v22, v23},
+		{v30, v31, v32, v33},
+	}}
+}
+
+// NewMatrix4x4Identity returns the identity matrix.
+func NewMatrix4x4Identity() Matrix4x4 {
+	return NewMatrix4x4(
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// NewMatrix4x4FromRows creates a matrix from four row vectors.
+func NewMatrix4x4FromRows(r0, r1, r2, r3 Vec4) Matrix4x4 {
+	return NewMatrix4x4(
+		r0.x, r0.y, r0.z, r0.w,
+		r1.x, r1.y, r1.z, r1.w,
+		r2.x, r2.y, r2.z, r2.w,
+		r3.x, r3.y, r3.z, r3.w,
+	)
+}
+
+// NewMatrix4x4FromColumns creates a matrix from four column vectors.
+func NewMatrix4x4FromColumns(c0, c1, c2, c3 Vec4) Matrix4x4 {
+	return NewMatrix4x4FromRows(c0, c1, c2, c3).Transpose()
+}
+
+// NewMatrix4x4FromMatrix3x3 creates a homogeneous matrix whose upper left
+// 3x3 block is the given matrix.
+func NewMatrix4x4FromMatrix3x3(a Matrix3x3) Matrix4x4 {
+	r := NewMatrix4x4Identity()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r.m[i][j] = a.m[i][j]
+		}
+	}
+	return r
+}
+
+// NewMatrix4x4Translation creates a translation matrix.
+func NewMatrix4x4Translation(v Vec3) Matrix4x4 {
+	return NewMatrix4x4(
+		1, 0, 0, v.x,
+		0, 1, 0, v.y,
+		0, 0, 1, v.z,
+		0, 0, 0, 1,
+	)
+}
+
+// NewMatrix4x4Scale creates a scale matrix using the vector's coordinates
+// as scale factors.
+func NewMatrix4x4Scale(v Vec3) Matrix4x4 {
+	return NewMatrix4x4(
+		v.x, 0, 0, 0,
+		0, v.y, 0, 0,
+		0, 0, v.z, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// NewMatrix4x4Rotation creates a rotation matrix around an arbitrary axis.
+// The angle is given in radians. The axis doesn't need to be normalized, but
+// if it is a zero vector the identity matrix is returned.
+func NewMatrix4x4Rotation(axis Vec3, radians float64) Matrix4x4 {
+	if axis.Norm() == 0.0 {
+		return NewMatrix4x4Identity()
+	}
+	axis.Normalize()
+
+	sin, cos := math.Sincos(radians)
+	t := 1.0 - cos
+	x, y, z := axis.x, axis.y, axis.z
+
+	return NewMatrix4x4(
+		t*x*x+cos, t*x*y-sin*z, t*x*z+sin*y, 0,
+		t*x*y+sin*z, t*y*y+cos, t*y*z-sin*x, 0,
+		t*x*z-sin*y, t*y*z+sin*x, t*z*z+cos, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// NewMatrix4x4FromYawPitchRoll creates a rotation matrix from yaw, pitch and roll
+// angles given in radians, following the same convention as NewMatrix3x3FromYawPitchRoll.
+func NewMatrix4x4FromYawPitchRoll(yaw, pitch, roll float64) Matrix4x4 {
+	return NewMatrix4x4FromMatrix3x3(NewMatrix3x3FromYawPitchRoll(yaw, pitch, roll))
+}
+
+// NewMatrix4x4LookAt creates a right-handed view matrix for a camera placed at eye,
+// looking at target, with the given up direction. Returns ErrDegenerateView if eye
+// and target are the same point, or if the view direction is parallel to up.
+func NewMatrix4x4LookAt(eye, target, up Vec3) (Matrix4x4, error) {
+	f := target.Subtract(eye)
+	if f.Norm() == 0.0 {
+		return Matrix4x4{}, ErrDegenerateView
+	}
+	f.Normalize()
+	s := Cross(f, up)
+	if s.Norm() == 0.0 {
+		return Matrix4x4{}, ErrDegenerateView
+	}
+	s.Normalize()
+	u := Cross(s, f)
+
+	return NewMatrix4x4(
+		s.x, s.y, s.z, -Dot(s, eye),
+		u.x, u.y, u.z, -Dot(u, eye),
+		-f.x, -f.y, -f.z, Dot(f, eye),
+		0, 0, 0, 1,
+	), nil
+}
+
+// NewMatrix4x4Perspective creates a right-handed perspective projection matrix.
+//
+// fovy is the vertical field of view in radians, aspect is the width/height ratio,
+// and near and far are the positive distances of the clipping planes. The view
+// volume is mapped to normalized device coordinates in [-1, 1]. Returns
+// ErrDegenerateView if fovy is not in the interval (0, Pi), if aspect is 0,
+// or if near and far are equal.
+func NewMatrix4x4Perspective(fovy, aspect, near, far float64) (Matrix4x4, error) {
+	if !(fovy > 0.0 && fovy < math.Pi) || aspect == 0.0 || near == far {
+		return Matrix4x4{}, ErrDegenerateView
+	}
+	f := 1.0 / math.Tan(fovy/2.0)
+	nf := 1.0 / (near - far)
+
+	return NewMatrix4x4(
+		f/aspect, 0, 0, 0,
+		0, f, 0, 0,
+		0, 0, (far+near)*nf, 2.0*far*near*nf,
+		0, 0, -1, 0,
+	), nil
+}
+
+// NewMatrix4x4Orthographic creates a right-handed orthographic projection matrix.
+// The view volume is mapped to normalized device coordinates in [-1, 1].
+// Returns ErrDegenerateView if the volume has no width, height or depth.
+func NewMatrix4x4Orthographic(left, right, bottom, top, near, far float64) (Matrix4x4, error) {
+	if left == right || bottom == top || near == far {
+		return Matrix4x4{}, ErrDegenerateView
+	}
+	rl := 1.0 / (right - left)
+	tb := 1.0 / (top - bottom)
+	fn := 1.0 / (far - near)
+
+	return NewMatrix4x4(
+		2.0*rl, 0, 0, -(right+left)*rl,
+		0, 2.0*tb, 0, -(top+bottom)*tb,
+		0, 0, -2.0*fn, -(far+near)*fn,
+		0, 0, 0, 1,
+	), nil
+}
+
+// At returns the element at the given row and column.
+func (m Matrix4x4) At(row, col int) float64 {
+	return m.m[row][col]
+}
+
+// Row returns the matrix's row as a vector.
+func (m Matrix4x4) Row(i int) Vec4 {
+	return NewVec4(m.m[i][0], m.m[i][1], m.m[i][2], m.m[i][3])
+}
+
+// Column returns the matrix's column as a vector.
+func (m Matrix4x4) Column(i int) Vec4 {
+	return NewVec4(m.m[0][i], m.m[1][i], m.m[2][i], m.m[3][i])
+}
+
+// AsArray returns the matrix elements as an array in row-major order.
+func (m Matrix4x4) AsArray() [16]float64 {
+	var r [16]float64
+	for i := 0; i < 4; i++ {
+		copy(r[i*4:], m.m[i][:])
+	}
+	return r
+}
+
+// Multiply implements matrix multiplication. The result is m * a.
+func (m Matrix4x4) Multiply(a Matrix4x4) Matrix4x4 {
+	var r Matrix4x4
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			r.m[i][j] = m.m[i][0]*a.m[0][j] + m.m[i][1]*a.m[1][j] +
+				m.m[i][2]*a.m[2][j] + m.m[i][3]*a.m[3][j]
+		}
+	}
+	return r
+}
+
+// MulVec4 multiplies the matrix by a column vector. The result is m * v.
+func (m Matrix4x4) MulVec4(v Vec4) Vec4 {
+	return NewVec4(
+		Dot4(m.Row(0), v),
+		Dot4(m.Row(1), v),
+		Dot4(m.Row(2), v),
+		Dot4(m.Row(3), v),
+	)
+}
+
+// TransformPoint transforms a 3D point, converting it to homogeneous
+// coordinates and applying the perspective divide to the result.
+func (m Matrix4x4) TransformPoint(v Vec3) Vec3 {
+	return m.MulVec4(v.AsVec4()).AsVec3()
+}
+
+// Transpose returns the transposed matrix.
+func (m Matrix4x4) Transpose() Matrix4x4 {
+	var r Matrix4x4
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			r.m[i][j] = m.m[j][i]
+		}
+	}
+	return r
+}
+
+// cofactors returns the 2x2 sub-determinants of the upper and lower halves
+// of the matrix, which are shared by Determinant and Inverse.
+func (m Matrix4x4) cofactors() (s, c [6]float64) {
+	a := m.m
+	s[0] = a[0][0]*a[1][1] - a[1][0]*a[0][1]
+	s[1] = a[0][0]*a[1][2] - a[1][0]*a[0][2]
+	s[2] = a[0][0]*a[1][3] - a[1][0]*a[0][3]
+	s[3] = a[0][1]*a[1][2] - a[1][1]*a[0][2]
+	s[4] = a[0][1]*a[1][3] - a[1][1]*a[0][3]
+	s[5] = a[0][2]*a[1][3] - a[1][2]*a[0][3]
+
+	c[5] = a[2][2]*a[3][3] - a[3][2]*a[2][3]
+	c[4] = a[2][1]*a[3][3] - a[3][1]*a[2][3]
+	c[3] = a[2][1]*a[3][2] - a[3][1]*a[2][2]
+	c[2] = a[2][0]*a[3][3] - a[3][0]*a[2][3]
+	c[1] = a[2][0]*a[3][2] - a[3][0]*a[2][2]
+	c[0] = a[2][0]*a[3][1] - a[3][0]*a[2][1]
+	return s, c
+}
+
+// Determinant calculates the matrix's determinant.
+func (m Matrix4x4) Determinant() float64 {
+	s, c := m.cofactors()
+	return s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+}
+
+// Inverse calculates the inverse matrix.
+// Returns ErrSingularMatrix if the matrix determinant is zero.
+func (m Matrix4x4) Inverse() (Matrix4x4, error) {
+	s, c := m.cofactors()
+	det := s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+	if det == 0.0 {
+		return Matrix4x4{}, ErrSingularMatrix
+	}
+	invdet := 1.0 / det
+	a := m.m
+
+	return NewMatrix4x4(
+		(a[1][1]*c[5]-a[1][2]*c[4]+a[1][3]*c[3])*invdet,
+		(-a[0][1]*c[5]+a[0][2]*c[4]-a[0][3]*c[3])*invdet,
+		(a[3][1]*s[5]-a[3][2]*s[4]+a[3][3]*s[3])*invdet,
+		(-a[2][1]*s[5]+a[2][2]*s[4]-a[2][3]*s[3])*invdet,
+
+		(-a[1][0]*c[5]+a[1][2]*c[2]-a[1][3]*c[1])*invdet,
+		(a[0][0]*c[5]-a[0][2]*c[2]+a[0][3]*c[1])*invdet,
+		(-a[3][0]*s[5]+a[3][2]*s[2]-a[3][3]*s[1])*invdet,
+		(a[2][0]*s[5]-a[2][2]*s[2]+a[2][3]*s[1])*invdet,
+
+		(a[1][0]*c[4]-a[1][1]*c[2]+a[1][3]*c[0])*invdet,
+		(-a[0][0]*c[4]+a[0][1]*c[2]-a[0][3]*c[0])*invdet,
+		(a[3][0]*s[4]-a[3][1]*s[2]+a[3][3]*s[0])*invdet,
+		(-a[2][0]*s[4]+a[2][1]*s[2]-a[2][3]*s[0])*invdet,
+
+		(-a[1][0]*c[3]+a[1][1]*c[1]-a[1][2]*c[0])*invdet,
+		(a[0][0]*c[3]-a[0][1]*c[1]+a[0][2]*c[0])*invdet,
+		(-a[3][0]*s[3]+a[3][1]*s[1]-a[3][2]*s[0])*invdet,
+		(a[2][0]*s[3]-a[2][1]*s[1]+a[2][2]*s[0])*invdet,
+	), nil
+}
+
+// Equals compares float numbers using epsilon.
+func (m Matrix4x4) Equals(a Matrix4x4, epsilon float64) bool {
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if math.Abs(m.m[i][j]-a.m[i][j]) >= epsilon {
+				return false
+			}
+		}
+	}
+	return true
+}
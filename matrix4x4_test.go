@@ -0,0 +1,143 @@
+package cmath
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatrix4x4(t *testing.T) {
+	identity := NewMatrix4x4Identity()
+	m1 := NewMatrix4x4(
+		2, 0, 0, 1,
+		0, 1, 3, 0,
+		1, 0, 1, 2,
+		0, 4, 0, 1,
+	)
+	singular := NewMatrix4x4(
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+		13, 14, 15, 16,
+	)
+
+	t.Run("test rows, columns and asarray", func(t *testing.T) {
+		assert.Equal(t, NewVec4(0, 1, 3, 0), m1.Row(1))
+		assert.Equal(t, NewVec4(1, 0, 2, 1), m1.Column(3))
+		assert.Equal(t, 3.0, m1.At(1, 2))
+		assert.Equal(t, m1, NewMatrix4x4FromRows(m1.Row(0), m1.Row(1), m1.Row(2), m1.Row(3)))
+		assert.Equal(t, m1, NewMatrix4x4FromColumns(m1.Column(0), m1.Column(1), m1.Column(2), m1.Column(3)))
+		assert.Equal(t, [16]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, singular.AsArray())
+	})
+
+	t.Run("test multiply method", func(t *testing.T) {
+		assert.Equal(t, m1, m1.Multiply(identity))
+		assert.Equal(t, m1, identity.Multiply(m1))
+	})
+
+	t.Run("test transpose method", func(t *testing.T) {
+		result := singular.Transpose()
+		assert.Equal(t, NewVec4(1, 5, 9, 13), result.Row(0))
+		assert.Equal(t, singular, result.Transpose())
+	})
+
+	t.Run("test determinant method", func(t *testing.T) {
+		assert.InDelta(t, 1.0, identity.Determinant(), 0.0001)
+		assert.InDelta(t, 0.0, singular.Determinant(), 0.0001)
+		assert.InDelta(t, 38.0, m1.Determinant(), 0.0001)
+	})
+
+	t.Run("test inverse method", func(t *testing.T) {
+		result, err := m1.Inverse()
+		assert.Nil(t, err)
+		assert.True(t, identity.Equals(m1.Multiply(result), 0.0001))
+		assert.True(t, identity.Equals(result.Multiply(m1), 0.0001))
+
+		_, err = singular.Inverse()
+		assert.ErrorIs(t, err, ErrSingularMatrix)
+	})
+
+	t.Run("test translation and scale", func(t *testing.T) {
+		p := NewVec3(1, 2, 3)
+		result := NewMatrix4x4Translation(NewVec3(1, -1, 2)).TransformPoint(p)
+		assert.Equal(t, NewVec3(2, 1, 5), result)
+
+		result = NewMatrix4x4Scale(NewVec3(2, 3, 4)).TransformPoint(p)
+		assert.Equal(t, NewVec3(2, 6, 12), result)
+
+		// directions are not affected by translations
+		dir := NewMatrix4x4Translation(NewVec3(1, 1, 1)).MulVec4(NewVec4(1, 0, 0, 0))
+		assert.Equal(t, NewVec4(1, 0, 0, 0), dir)
+	})
+
+	t.Run("test rotation", func(t *testing.T) {
+		result := NewMatrix4x4Rotation(NewVec3(0, 0, 2), math.Pi/2).TransformPoint(NewVec3(1, 0, 0))
+		assert.True(t, result.Equals(NewVec3(0, 1, 0), 0.0001))
+
+		m := NewMatrix4x4Rotation(NewVec3(1, 1, 1), 2*math.Pi/3)
+		result = m.TransformPoint(NewVec3(1, 0, 0))
+		assert.True(t, result.Equals(NewVec3(0, 1, 0), 0.0001))
+
+		assert.Equal(t, identity, NewMatrix4x4Rotation(NewVec3(0, 0, 0), 1.0))
+	})
+
+	t.Run("test yaw pitch roll", func(t *testing.T) {
+		m := NewMatrix4x4FromYawPitchRoll(0.3, -0.5, 1.2)
+		expected := NewMatrix4x4FromMatrix3x3(NewMatrix3x3FromYawPitchRoll(0.3, -0.5, 1.2))
+		assert.Equal(t, expected, m)
+		assert.Equal(t, NewVec4(0, 0, 0, 1), m.Row(3))
+	})
+
+	t.Run("test look at", func(t *testing.T) {
+		eye := NewVec3(0, 0, 5)
+		view, err := NewMatrix4x4LookAt(eye, NewVec3(0, 0, 0), NewVec3(0, 1, 0))
+		assert.Nil(t, err)
+		assert.True(t, view.TransformPoint(eye).Equals(NewVec3(0, 0, 0), 0.0001))
+		assert.True(t, view.TransformPoint(NewVec3(0, 0, 0)).Equals(NewVec3(0, 0, -5), 0.0001))
+		assert.True(t, view.TransformPoint(NewVec3(1, 0, 5)).Equals(NewVec3(1, 0, 0), 0.0001))
+
+		// looking along the up direction has no defined side axis
+		_, err = NewMatrix4x4LookAt(NewVec3(0, 0, 0), NewVec3(0, 5, 0), NewVec3(0, 1, 0))
+		assert.ErrorIs(t, err, ErrDegenerateView)
+		_, err = NewMatrix4x4LookAt(eye, eye, NewVec3(0, 1, 0))
+		assert.ErrorIs(t, err, ErrDegenerateView)
+	})
+
+	t.Run("test perspective", func(t *testing.T) {
+		proj, err := NewMatrix4x4Perspective(math.Pi/2, 1.0, 1.0, 10.0)
+		assert.Nil(t, err)
+		near := proj.TransformPoint(NewVec3(0, 0, -1))
+		far := proj.TransformPoint(NewVec3(0, 0, -10))
+		assert.InDelta(t, -1.0, near.Z(), 0.0001)
+		assert.InDelta(t, 1.0, far.Z(), 0.0001)
+
+		edge := proj.TransformPoint(NewVec3(2, 2, -2))
+		assert.InDelta(t, 1.0, edge.X(), 0.0001)
+		assert.InDelta(t, 1.0, edge.Y(), 0.0001)
+
+		_, err = NewMatrix4x4Perspective(0, 1.0, 1.0, 10.0)
+		assert.ErrorIs(t, err, ErrDegenerateView)
+		_, err = NewMatrix4x4Perspective(math.Pi/2, 0, 1.0, 10.0)
+		assert.ErrorIs(t, err, ErrDegenerateView)
+		_, err = NewMatrix4x4Perspective(math.Pi/2, 1.0, 5.0, 5.0)
+		assert.ErrorIs(t, err, ErrDegenerateView)
+	})
+
+	t.Run("test orthographic", func(t *testing.T) {
+		proj, err := NewMatrix4x4Orthographic(-2, 2, -1, 1, 1, 11)
+		assert.Nil(t, err)
+		result := proj.TransformPoint(NewVec3(2, -1, -1))
+		assert.True(t, result.Equals(NewVec3(1, -1, -1), 0.0001))
+
+		result = proj.TransformPoint(NewVec3(0, 0, -11))
+		assert.True(t, result.Equals(NewVec3(0, 0, 1), 0.0001))
+
+		_, err = NewMatrix4x4Orthographic(2, 2, -1, 1, 1, 11)
+		assert.ErrorIs(t, err, ErrDegenerateView)
+		_, err = NewMatrix4x4Orthographic(-2, 2, 1, 1, 1, 11)
+		assert.ErrorIs(t, err, ErrDegenerateView)
+		_, err = NewMatrix4x4Orthographic(-2, 2, -1, 1, 3, 3)
+		assert.ErrorIs(t, err, ErrDegenerateView)
+	})
+}
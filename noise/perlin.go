@@ -0,0 +1,178 @@
+// # Noise
+//
+// This package contains a seeded perlin noise generator for one,
+// two and three dimensional procedural textures.
+package noise
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/jgardona/cmath"
+)
+
+var (
+	ErrBadOctaves     = errors.New("octaves must be greater than or equal to 1")
+	ErrBadPersistence = errors.New("persistence must be a positive number")
+	ErrBadFrequency   = errors.New("frequency must be a positive number")
+)
+
+// PerlinNoise is a gradient noise generator, which sums several octaves of
+// improved perlin noise. Each octave doubles the frequency of the previous
+// one, and multiplies its amplitude by the persistence.
+//
+// # Note
+//
+// The generator is deterministic: the same seed always produces the same output.
+type PerlinNoise struct {
+	// perm is a slice, so copying the generator doesn't copy the table.
+	perm        []int
+	octaves     int
+	persistence float64
+	frequency   float64
+}
+
+// NewPerlinNoise instantiates a perlin noise generator given the seed,
+// the number of octaves, the persistence and the initial frequency.
+func NewPerlinNoise(seed int64, octaves int, persistence, frequency float64) (PerlinNoise, error) {
+	if octaves < 1 {
+		return PerlinNoise{}, ErrBadOctaves
+	}
+	if !(persistence > 0.0) {
+		return PerlinNoise{}, ErrBadPersistence
+	}
+	if !(frequency > 0.0) {
+		return PerlinNoise{}, ErrBadFrequency
+	}
+
+	p := PerlinNoise{perm: make([]int, 512), octaves: octaves, persistence: persistence, frequency: frequency}
+	r := rand.New(rand.NewSource(seed))
+	for i, e := range r.Perm(256) {
+		p.perm[i] = e
+		p.perm[i+256] = e
+	}
+	return p, nil
+}
+
+// Octaves returns the number of octaves.
+func (p PerlinNoise) Octaves() int {
+	return p.octaves
+}
+
+// Persistence returns the persistence value.
+func (p PerlinNoise) Persistence() float64 {
+	return p.persistence
+}
+
+// Frequency returns the initial frequency.
+func (p PerlinNoise) Frequency() float64 {
+	return p.frequency
+}
+
+// Function evaluates the 1D noise at x.
+func (p PerlinNoise) Function(x float64) float64 {
+	return p.sum(func(f float64) float64 {
+		return p.noise1(x * f)
+	})
+}
+
+// Function2D evaluates the 2D noise at (x, y).
+func (p PerlinNoise) Function2D(x, y float64) float64 {
+	return p.sum(func(f float64) float64 {
+		return p.noise3(x*f, y*f, 0.0)
+	})
+}
+
+// Function3D evaluates the 3D noise at the given point.
+func (p PerlinNoise) Function3D(v cmath.Vec3) float64 {
+	return p.sum(func(f float64) float64 {
+		return p.noise3(v.X()*f, v.Y()*f, v.Z()*f)
+	})
+}
+
+// sum adds all octaves of the noise, given a function which evaluates
+// a single octave at the specified frequency.
+func (p PerlinNoise) sum(octave func(frequency float64) float64) float64 {
+	var total float64
+	frequency, amplitude := p.frequency, 1.0
+	for i := 0; i < p.octaves; i++ {
+		total += octave(frequency) * amplitude
+		frequency *= 2.0
+		amplitude *= p.persistence
+	}
+	return total
+}
+
+// fade is the quintic interpolation curve 6t^5 - 15t^4 + 10t^3.
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6.0-15.0) + 10.0)
+}
+
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+// grad1 returns the dot product of x with one of 16 gradients in [-8, 8],
+// scaled down to keep the 1D noise in [-1, 1].
+func grad1(hash int, x float64) float64 {
+	g := float64(1 + hash&7)
+	if hash&8 != 0 {
+		g = -g
+	}
+	return g * x / 4.0
+}
+
+// grad3 returns the dot product of (x, y, z) with one of the 12 cube
+// edge gradients of the improved perlin noise.
+func grad3(hash int, x, y, z float64) float64 {
+	h := hash & 15
+	u := y
+	if h < 8 {
+		u = x
+	}
+	v := z
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
+
+// noise1 evaluates a single octave of 1D gradient noise.
+func (p PerlinNoise) noise1(x float64) float64 {
+	fx := math.Floor(x)
+	xi := int(fx) & 255
+	x -= fx
+
+	return lerp(fade(x), grad1(p.perm[xi], x), grad1(p.perm[xi+1], x-1.0))
+}
+
+// noise3 evaluates a single octave of 3D improved perlin noise.
+func (p PerlinNoise) noise3(x, y, z float64) float64 {
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	xi, yi, zi := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z = x-fx, y-fy, z-fz
+	u, v, w := fade(x), fade(y), fade(z)
+
+	perm := p.perm
+	a := perm[xi] + yi
+	aa, ab := perm[a]+zi, perm[a+1]+zi
+	b := perm[xi+1] + yi
+	ba, bb := perm[b]+zi, perm[b+1]+zi
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad3(perm[aa], x, y, z), grad3(perm[ba], x-1, y, z)),
+			lerp(u, grad3(perm[ab], x, y-1, z), grad3(perm[bb], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, grad3(perm[aa+1], x, y, z-1), grad3(perm[ba+1], x-1, y, z-1)),
+			lerp(u, grad3(perm[ab+1], x, y-1, z-1), grad3(perm[bb+1], x-1, y-1, z-1))))
+}
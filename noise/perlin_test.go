@@ -0,0 +1,83 @@
+package noise
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jgardona/cmath"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerlinNoise(t *testing.T) {
+	p, err := NewPerlinNoise(42, 4, 0.5, 1.0)
+	assert.Nil(t, err)
+
+	t.Run("test parameters validation", func(t *testing.T) {
+		_, err := NewPerlinNoise(1, 0, 0.5, 1.0)
+		assert.ErrorIs(t, err, ErrBadOctaves)
+		_, err = NewPerlinNoise(1, 1, 0.0, 1.0)
+		assert.ErrorIs(t, err, ErrBadPersistence)
+		_, err = NewPerlinNoise(1, 1, 0.5, math.NaN())
+		assert.ErrorIs(t, err, ErrBadFrequency)
+
+		assert.Equal(t, 4, p.Octaves())
+		assert.Equal(t, 0.5, p.Persistence())
+		assert.Equal(t, 1.0, p.Frequency())
+	})
+
+	t.Run("test noise is zero at integer lattice points", func(t *testing.T) {
+		single, _ := NewPerlinNoise(7, 1, 0.5, 1.0)
+		assert.Equal(t, 0.0, single.Function(3))
+		assert.Equal(t, 0.0, single.Function2D(1, -2))
+		assert.Equal(t, 0.0, single.Function3D(cmath.NewVec3(4, 5, -6)))
+	})
+
+	t.Run("test output is deterministic for a seed", func(t *testing.T) {
+		other, _ := NewPerlinNoise(42, 4, 0.5, 1.0)
+		different, _ := NewPerlinNoise(43, 4, 0.5, 1.0)
+		v := cmath.NewVec3(0.3, 1.7, -2.2)
+
+		assert.Equal(t, p.Function(0.37), other.Function(0.37))
+		assert.Equal(t, p.Function2D(0.37, 5.1), other.Function2D(0.37, 5.1))
+		assert.Equal(t, p.Function3D(v), other.Function3D(v))
+		assert.NotEqual(t, p.Function3D(v), different.Function3D(v))
+	})
+
+	t.Run("test 2d noise is the 3d noise at z zero", func(t *testing.T) {
+		assert.Equal(t, p.Function2D(1.25, -3.5), p.Function3D(cmath.NewVec3(1.25, -3.5, 0)))
+	})
+
+	t.Run("test output is bounded and continuous", func(t *testing.T) {
+		// the sum of octaves is bounded by the sum of amplitudes
+		bound := 1.0 + 0.5 + 0.25 + 0.125
+		previous := p.Function2D(0, 0.1)
+		for i := 1; i < 2000; i++ {
+			x := float64(i) * 0.01
+			v1 := p.Function(x)
+			v2 := p.Function2D(x, 0.1)
+			v3 := p.Function3D(cmath.NewVec3(x, 0.1, 0.7))
+			assert.LessOrEqual(t, math.Abs(v1), bound)
+			assert.LessOrEqual(t, math.Abs(v2), bound)
+			assert.LessOrEqual(t, math.Abs(v3), bound)
+			assert.Less(t, math.Abs(v2-previous), 0.2)
+			previous = v2
+		}
+	})
+}
+
+func BenchmarkPerlinNoise(b *testing.B) {
+	p, _ := NewPerlinNoise(42, 4, 0.5, 1.0)
+	v := cmath.NewVec3(0.3, 1.7, -2.2)
+
+	b.Run("benchmark 2d noise", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = p.Function2D(0.3, 1.7)
+		}
+	})
+
+	b.Run("benchmark 3d noise", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = p.Function3D(v)
+		}
+	})
+}
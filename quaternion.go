@@ -0,0 +1,266 @@
+// # quaternion
+//
+// This package contains a quaternion structure used to represent 3D rotations.
+package cmath
+
+import "math"
+
+// # Quaternion
+//
+// A structure representing a quaternion w + xi + yj + zk.
+// Unit quaternions are used to represent rotations of Vec3 values.
+type Quaternion struct {
+	w float64
+	x float64
+	y float64
+	z float64
+}
+
+// NewQuaternion initializes a Quaternion structure, where w is
+// the scalar part and x, y, z the vector part.
+func NewQuaternion(w, x, y, z float64) Quaternion {
+	return Quaternion{w, x, y, z}
+}
+
+// NewQuaternionIdentity returns the identity quaternion, which represents no rotation.
+func NewQuaternionIdentity() Quaternion {
+	return Quaternion{1, 0, 0, 0}
+}
+
+// NewQuaternionFromAxisAngle creates a rotation quaternion around an arbitrary axis.
+// The angle is given in radians. The axis doesn't need to be normalized, but
+// if it is a zero vector the identity quaternion is returned.
+func NewQuaternionFromAxisAngle(axis Vec3, radians float64) Quaternion {
+	if axis.Norm() == 0.0 {
+		return NewQuaternionIdentity()
+	}
+	axis.Normalize()
+	sin, cos := math.Sincos(radians / 2.0)
+	return Quaternion{cos, axis.x * sin, axis.y * sin, axis.z * sin}
+}
+
+// NewQuaternionFromYawPitchRoll creates a rotation quaternion from yaw, pitch and roll
+// angles given in radians, following the same convention as NewMatrix3x3FromYawPitchRoll.
+func NewQuaternionFromYawPitchRoll(yaw, pitch, roll float64) Quaternion {
+	qy := NewQuaternionFromAxisAngle(NewVec3(0, 1, 0), yaw)
+	qx := NewQuaternionFromAxisAngle(NewVec3(1, 0, 0), pitch)
+	qz := NewQuaternionFromAxisAngle(NewVec3(0, 0, 1), roll)
+	return qy.Multiply(qx).Multiply(qz)
+}
+
+// NewQuaternionFromMatrix3x3 creates a rotation quaternion from a rotation matrix.
+// The matrix must be orthonormal, otherwise the result is meaningless.
+func NewQuaternionFromMatrix3x3(m Matrix3x3) Quaternion {
+	a := m.m
+	trace := a[0][0] + a[1][1] + a[2][2]
+
+	var q Quaternion
+	if trace > 0 {
+		s := 0.5 / math.Sqrt(trace+1.0)
+		q = Quaternion{
+			0.25 / s,
+			(a[2][1] - a[1][2]) * s,
+			(a[0][2] - a[2][0]) * s,
+			(a[1][0] - a[0][1]) * s,
+		}
+	} else if a[0][0] > a[1][1] && a[0][0] > a[2][2] {
+		s := 2.0 * math.Sqrt(1.0+a[0][0]-a[1][1]-a[2][2])
+		q = Quaternion{
+			(a[2][1] - a[1][2]) / s,
+			0.25 * s,
+			(a[0][1] + a[1][0]) / s,
+			(a[0][2] + a[2][0]) / s,
+		}
+	} else if a[1][1] > a[2][2] {
+		s := 2.0 * math.Sqrt(1.0+a[1][1]-a[0][0]-a[2][2])
+		q = Quaternion{
+			(a[0][2] - a[2][0]) / s,
+			(a[0][1] + a[1][0]) / s,
+			0.25 * s,
+			(a[1][2] + a[2][1]) / s,
+		}
+	} else {
+		s := 2.0 * math.Sqrt(1.0+a[2][2]-a[0][0]-a[1][1])
+		q = Quaternion{
+			(a[1][0] - a[0][1]) / s,
+			(a[0][2] + a[2][0]) / s,
+			(a[1][2] + a[2][1]) / s,
+			0.25 * s,
+		}
+	}
+	q.Normalize()
+	return q
+}
+
+// NewQuaternionFromMatrix4x4 creates a rotation quaternion from the upper left
+// 3x3 block of a homogeneous matrix.
+func NewQuaternionFromMatrix4x4(m Matrix4x4) Quaternion {
+	var r Matrix3x3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r.m[i][j] = m.m[i][j]
+		}
+	}
+	return NewQuaternionFromMatrix3x3(r)
+}
+
+// Sum implements quaternion addition.
+func (q Quaternion) Sum(a Quaternion) Quaternion {
+	return Quaternion{q.w + a.w, q.x + a.x, q.y + a.y, q.z + a.z}
+}
+
+// MulScalar implements scalar quaternion multiplication.
+func (q Quaternion) MulScalar(scalar float64) Quaternion {
+	return Quaternion{q.w * scalar, q.x * scalar, q.y * scalar, q.z * scalar}
+}
+
+// Multiply implements the Hamilton product q * a. When used with rotations,
+// the resulting quaternion applies a first and then q.
+func (q Quaternion) Multiply(a Quaternion) Quaternion {
+	return Quaternion{
+		q.w*a.w - q.x*a.x - q.y*a.y - q.z*a.z,
+		q.w*a.x + q.x*a.w + q.y*a.z - q.z*a.y,
+		q.w*a.y - q.x*a.z + q.y*a.w + q.z*a.x,
+		q.w*a.z + q.x*a.y - q.y*a.x + q.z*a.w,
+	}
+}
+
+// Conjugate returns the quaternion's conjugate w - xi - yj - zk.
+func (q Quaternion) Conjugate() Quaternion {
+	return Quaternion{q.w, -q.x, -q.y, -q.z}
+}
+
+// Norm returns the norm of a quaternion.
+func (q Quaternion) Norm() float64 {
+	return math.Sqrt(q.w*q.w + q.x*q.x + q.y*q.y + q.z*q.z)
+}
+
+// Dot calculates the dot product of two quaternions.
+func (q Quaternion) Dot(a Quaternion) float64 {
+	return q.w*a.w + q.x*a.x + q.y*a.y + q.z*a.z
+}
+
+// Normalize normalizes this quaternion by dividing its components with the quaternion's norm.
+// Returns the value of quaternion's norm before normalization.
+func (q *Quaternion) Normalize() float64 {
+	norm := q.Norm()
+	invn := 1.0 / norm
+	q.w *= invn
+	q.x *= invn
+	q.y *= invn
+	q.z *= invn
+	return norm
+}
+
+// Inverse returns the multiplicative inverse of the quaternion (or a zero
+// quaternion if this quaternion is also zero). For unit quaternions the
+// inverse is equal to the conjugate.
+func (q Quaternion) Inverse() Quaternion {
+	n := q.Dot(q)
+	if n == 0.0 {
+		return Quaternion{}
+	}
+	return q.Conjugate().MulScalar(1.0 / n)
+}
+
+// Rotate rotates a vector by this quaternion, which must be a unit quaternion.
+func (q Quaternion) Rotate(v Vec3) Vec3 {
+	u := NewVec3(q.x, q.y, q.z)
+	t := Cross(u, v).MulScalar(2.0)
+	return v.Sum(t.MulScalar(q.w)).Sum(Cross(u, t))
+}
+
+// AxisAngle returns the rotation axis and the angle in radians represented
+// by this unit quaternion. For the identity rotation the x axis is returned.
+func (q Quaternion) AxisAngle() (Vec3, float64) {
+	sin := math.Sqrt(q.x*q.x + q.y*q.y + q.z*q.z)
+	if sin == 0.0 {
+		return NewVec3(1, 0, 0), 0.0
+	}
+	angle := 2.0 * math.Atan2(sin, q.w)
+	return NewVec3(q.x/sin, q.y/sin, q.z/sin), angle
+}
+
+// AsMatrix3x3 returns the rotation matrix represented by this unit quaternion.
+func (q Quaternion) AsMatrix3x3() Matrix3x3 {
+	xx, yy, zz := q.x*q.x, q.y*q.y, q.z*q.z
+	xy, xz, yz := q.x*q.y, q.x*q.z, q.y*q.z
+	wx, wy, wz := q.w*q.x, q.w*q.y, q.w*q.z
+
+	return NewMatrix3x3(
+		1-2*(yy+zz), 2*(xy-wz), 2*(xz+wy),
+		2*(xy+wz), 1-2*(xx+zz), 2*(yz-wx),
+		2*(xz-wy), 2*(yz+wx), 1-2*(xx+yy),
+	)
+}
+
+// AsMatrix4x4 returns the homogeneous rotation matrix represented by this unit quaternion.
+func (q Quaternion) AsMatrix4x4() Matrix4x4 {
+	return NewMatrix4x4FromMatrix3x3(q.AsMatrix3x3())
+}
+
+// Equals compares float numbers using epsilon.
+func (q Quaternion) Equals(a Quaternion, epsilon float64) bool {
+	dw := math.Abs(q.w-a.w) < epsilon
+	dx := math.Abs(q.x-a.x) < epsilon
+	dy := math.Abs(q.y-a.y) < epsilon
+	dz := math.Abs(q.z-a.z) < epsilon
+	return dw && dx && dy && dz
+}
+
+// W returns the quaternion's scalar part.
+func (q Quaternion) W() float64 {
+	return q.w
+}
+
+// X returns the quaternion's x component.
+func (q Quaternion) X() float64 {
+	return q.x
+}
+
+// Y returns the quaternion's y component.
+func (q Quaternion) Y() float64 {
+	return q.y
+}
+
+// Z returns the quaternion's z component.
+func (q Quaternion) Z() float64 {
+	return q.z
+}
+
+// Nlerp interpolates linearly between two unit quaternions and normalizes
+// the result. The interpolation follows the shortest path and t is
+// expected to be in the [0, 1] interval.
+func Nlerp(a, b Quaternion, t float64) Quaternion {
+	if a.Dot(b) < 0.0 {
+		b = b.MulScalar(-1.0)
+	}
+	r := a.MulScalar(1.0 - t).Sum(b.MulScalar(t))
+	r.Normalize()
+	return r
+}
+
+// Slerp implements the spherical linear interpolation between two unit
+// quaternions. The interpolation follows the shortest path and t is
+// expected to be in the [0, 1] interval.
+//
+// # Note
+//
+// Nearly parallel quaternions fall back to Nlerp to avoid dividing by
+// a vanishing sine.
+func Slerp(a, b Quaternion, t float64) Quaternion {
+	cos := a.Dot(b)
+	if cos < 0.0 {
+		b = b.MulScalar(-1.0)
+		cos = -cos
+	}
+	if cos > 0.9995 {
+		return Nlerp(a, b, t)
+	}
+
+	theta := math.Acos(cos)
+	sin := math.Sin(theta)
+	wa := math.Sin((1.0-t)*theta) / sin
+	wb := math.Sin(t*theta) / sin
+	return a.MulScalar(wa).Sum(b.MulScalar(wb))
+}
@@ -0,0 +1,86 @@
+package cmath
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuaternion(t *testing.T) {
+	qz := NewQuaternionFromAxisAngle(NewVec3(0, 0, 1), math.Pi/2)
+	qx := NewQuaternionFromAxisAngle(NewVec3(1, 0, 0), math.Pi/2)
+
+	t.Run("test axis angle constructor", func(t *testing.T) {
+		expected := NewQuaternion(math.Sqrt2/2, 0, 0, math.Sqrt2/2)
+		assert.True(t, qz.Equals(expected, 0.0001))
+		assert.Equal(t, NewQuaternionIdentity(), NewQuaternionFromAxisAngle(NewVec3(0, 0, 0), 1))
+
+		axis, angle := qz.AxisAngle()
+		assert.True(t, axis.Equals(NewVec3(0, 0, 1), 0.0001))
+		assert.InDelta(t, math.Pi/2, angle, 0.0001)
+	})
+
+	t.Run("test rotate method", func(t *testing.T) {
+		result := qz.Rotate(NewVec3(1, 0, 0))
+		assert.True(t, result.Equals(NewVec3(0, 1, 0), 0.0001))
+
+		result = qx.Rotate(NewVec3(0, 1, 0))
+		assert.True(t, result.Equals(NewVec3(0, 0, 1), 0.0001))
+	})
+
+	t.Run("test hamilton product", func(t *testing.T) {
+		i := NewQuaternion(0, 1, 0, 0)
+		j := NewQuaternion(0, 0, 1, 0)
+		k := NewQuaternion(0, 0, 0, 1)
+		assert.Equal(t, k, i.Multiply(j))
+		assert.Equal(t, NewQuaternion(-1, 0, 0, 0), k.Multiply(k))
+
+		// qx applied first, then qz
+		v := NewVec3(0, 1, 0)
+		composed := qz.Multiply(qx).Rotate(v)
+		assert.True(t, composed.Equals(qz.Rotate(qx.Rotate(v)), 0.0001))
+	})
+
+	t.Run("test conjugate, inverse and normalize", func(t *testing.T) {
+		q := NewQuaternion(1, 2, 3, 4)
+		assert.Equal(t, NewQuaternion(1, -2, -3, -4), q.Conjugate())
+		assert.True(t, NewQuaternionIdentity().Equals(q.Multiply(q.Inverse()), 0.0001))
+		assert.Equal(t, Quaternion{}, Quaternion{}.Inverse())
+
+		norm := q.Normalize()
+		assert.InDelta(t, math.Sqrt(30), norm, 0.0001)
+		assert.InDelta(t, 1.0, q.Norm(), 0.0001)
+		assert.True(t, q.Inverse().Equals(q.Conjugate(), 0.0001))
+	})
+
+	t.Run("test matrix conversions", func(t *testing.T) {
+		q := NewQuaternionFromYawPitchRoll(0.3, -0.5, 1.2)
+		m := NewMatrix3x3FromYawPitchRoll(0.3, -0.5, 1.2)
+		assert.True(t, m.Equals(q.AsMatrix3x3(), 0.0001))
+		assert.True(t, NewMatrix4x4FromMatrix3x3(m).Equals(q.AsMatrix4x4(), 0.0001))
+
+		result := NewQuaternionFromMatrix3x3(m)
+		assert.True(t, q.Equals(result, 0.0001) || q.Equals(result.MulScalar(-1), 0.0001))
+
+		// rotations of 180 degrees exercise the non positive trace branches
+		for _, axis := range []Vec3{NewVec3(1, 0, 0), NewVec3(0, 1, 0), NewVec3(0, 0, 1)} {
+			expected := NewQuaternionFromAxisAngle(axis, math.Pi)
+			result := NewQuaternionFromMatrix4x4(expected.AsMatrix4x4())
+			assert.True(t, expected.Equals(result, 0.0001) || expected.Equals(result.MulScalar(-1), 0.0001))
+		}
+	})
+
+	t.Run("test slerp and nlerp", func(t *testing.T) {
+		identity := NewQuaternionIdentity()
+		half := NewQuaternionFromAxisAngle(NewVec3(0, 0, 1), math.Pi/4)
+
+		assert.True(t, Slerp(identity, qz, 0.5).Equals(half, 0.0001))
+		assert.True(t, Slerp(identity, qz, 0).Equals(identity, 0.0001))
+		assert.True(t, Slerp(identity, qz, 1).Equals(qz, 0.0001))
+		assert.True(t, Nlerp(identity, qz, 0.5).Equals(half, 0.0001))
+
+		// shortest path is taken for opposite hemispheres
+		assert.True(t, Slerp(identity, qz.MulScalar(-1), 0.5).Equals(half, 0.0001))
+	})
+}
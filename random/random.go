@@ -0,0 +1,224 @@
+// # Random
+//
+// This package contains seeded random number generators for uniform,
+// gaussian and exponential distributions, and helpers to fill slices
+// of values, vectors and points.
+package random
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/jgardona/cmath"
+	"github.com/jgardona/cmath/constraints"
+	"github.com/jgardona/cmath/points"
+	"github.com/jgardona/cmath/ranges"
+)
+
+var (
+	ErrBadRange  = errors.New("range length must be a positive number")
+	ErrBadStdDev = errors.New("standard deviation must be a positive number")
+	ErrBadRate   = errors.New("rate must be a positive number")
+)
+
+// Generator is a source of random numbers following some distribution.
+//
+// # Note
+//
+// Generators are deterministic: the same seed always produces the same
+// sequence. They are not safe for concurrent use.
+type Generator interface {
+	// Next returns the next random number.
+	Next() float64
+	// SetSeed reseeds the generator, restarting its sequence.
+	SetSeed(seed int64)
+	// Mean returns the mean of the generated numbers.
+	Mean() float64
+	// Variance returns the variance of the generated numbers.
+	Variance() float64
+}
+
+// UniformGenerator generates random numbers uniformly distributed in
+// the half open interval [min, max).
+type UniformGenerator struct {
+	source *rand.Rand
+	r      ranges.Range[float64]
+}
+
+// NewUniformGenerator instantiates a uniform generator given its range and seed.
+func NewUniformGenerator(r ranges.Range[float64], seed int64) (*UniformGenerator, error) {
+	if !(r.Length() > 0.0) || math.IsInf(r.Length(), 1) {
+		return nil, ErrBadRange
+	}
+	return &UniformGenerator{source: rand.New(rand.NewSource(seed)), r: r}, nil
+}
+
+// Range returns the generator's range.
+func (g *UniformGenerator) Range() ranges.Range[float64] {
+	return g.r
+}
+
+// Next returns the next random number.
+func (g *UniformGenerator) Next() float64 {
+	v := g.r.Min() + g.source.Float64()*g.r.Length()
+	// the sum may round up to max, which is outside the interval
+	if v >= g.r.Max() {
+		return math.Nextafter(g.r.Max(), math.Inf(-1))
+	}
+	return v
+}
+
+// SetSeed reseeds the generator, restarting its sequence.
+func (g *UniformGenerator) SetSeed(seed int64) {
+	g.source.Seed(seed)
+}
+
+// Mean returns the mean of the generated numbers.
+func (g *UniformGenerator) Mean() float64 {
+	return (g.r.Min() + g.r.Max()) / 2.0
+}
+
+// Variance returns the variance of the generated numbers.
+func (g *UniformGenerator) Variance() float64 {
+	return g.r.Length() * g.r.Length() / 12.0
+}
+
+// StandardGenerator generates random numbers following the standard
+// normal distribution, with zero mean and unit variance.
+type StandardGenerator struct {
+	source *rand.Rand
+}
+
+// NewStandardGenerator instantiates a standard generator given its seed.
+func NewStandardGenerator(seed int64) *StandardGenerator {
+	return &StandardGenerator{source: rand.New(rand.NewSource(seed))}
+}
+
+// Next returns the next random number.
+func (g *StandardGenerator) Next() float64 {
+	return g.source.NormFloat64()
+}
+
+// SetSeed reseeds the generator, restarting its sequence.
+func (g *StandardGenerator) SetSeed(seed int64) {
+	g.source.Seed(seed)
+}
+
+// Mean returns the mean of the generated numbers.
+func (g *StandardGenerator) Mean() float64 {
+	return 0.0
+}
+
+// Variance returns the variance of the generated numbers.
+func (g *StandardGenerator) Variance() float64 {
+	return 1.0
+}
+
+// GaussianGenerator generates random numbers following a normal distribution.
+type GaussianGenerator struct {
+	standard *StandardGenerator
+	mean     float64
+	stdDev   float64
+}
+
+// NewGaussianGenerator instantiates a gaussian generator given its mean,
+// standard deviation and seed.
+func NewGaussianGenerator(mean, stdDev float64, seed int64) (*GaussianGenerator, error) {
+	if !(stdDev > 0.0) || math.IsInf(stdDev, 1) {
+		return nil, ErrBadStdDev
+	}
+	return &GaussianGenerator{standard: NewStandardGenerator(seed), mean: mean, stdDev: stdDev}, nil
+}
+
+// StdDev returns the standard deviation of the generated numbers.
+func (g *GaussianGenerator) StdDev() float64 {
+	return g.stdDev
+}
+
+// Next returns the next random number.
+func (g *GaussianGenerator) Next() float64 {
+	return g.mean + g.stdDev*g.standard.Next()
+}
+
+// SetSeed reseeds the generator, restarting its sequence.
+func (g *GaussianGenerator) SetSeed(seed int64) {
+	g.standard.SetSeed(seed)
+}
+
+// Mean returns the mean of the generated numbers.
+func (g *GaussianGenerator) Mean() float64 {
+	return g.mean
+}
+
+// Variance returns the variance of the generated numbers.
+func (g *GaussianGenerator) Variance() float64 {
+	return g.stdDev * g.stdDev
+}
+
+// ExponentialGenerator generates random numbers following an exponential
+// distribution with the given rate.
+type ExponentialGenerator struct {
+	source *rand.Rand
+	rate   float64
+}
+
+// NewExponentialGenerator instantiates an exponential generator given its rate and seed.
+func NewExponentialGenerator(rate float64, seed int64) (*ExponentialGenerator, error) {
+	if !(rate > 0.0) || math.IsInf(rate, 1) {
+		return nil, ErrBadRate
+	}
+	return &ExponentialGenerator{source: rand.New(rand.NewSource(seed)), rate: rate}, nil
+}
+
+// Rate returns the generator's rate.
+func (g *ExponentialGenerator) Rate() float64 {
+	return g.rate
+}
+
+// Next returns the next random number.
+func (g *ExponentialGenerator) Next() float64 {
+	return g.source.ExpFloat64() / g.rate
+}
+
+// SetSeed reseeds the generator, restarting its sequence.
+func (g *ExponentialGenerator) SetSeed(seed int64) {
+	g.source.Seed(seed)
+}
+
+// Mean returns the mean of the generated numbers.
+func (g *ExponentialGenerator) Mean() float64 {
+	return 1.0 / g.rate
+}
+
+// Variance returns the variance of the generated numbers.
+func (g *ExponentialGenerator) Variance() float64 {
+	return 1.0 / (g.rate * g.rate)
+}
+
+// Fill sets every element of values with the next random number.
+func Fill(g Generator, values []float64) {
+	for i := range values {
+		values[i] = g.Next()
+	}
+}
+
+// FillVec3 sets every vector with random x, y and z coordinates.
+func FillVec3(g Generator, vectors []cmath.Vec3) {
+	for i := range vectors {
+		x, y, z := g.Next(), g.Next(), g.Next()
+		vectors[i] = cmath.NewVec3(x, y, z)
+	}
+}
+
+// FillPoints sets every point with random x and y coordinates.
+//
+// # Note
+//
+// For integer points, the random numbers are truncated toward zero.
+func FillPoints[T constraints.Numbers](g Generator, pts []points.Point[T]) {
+	for i := range pts {
+		x, y := g.Next(), g.Next()
+		pts[i] = points.NewPoint(T(x), T(y))
+	}
+}
@@ -0,0 +1,127 @@
+package random
+
+import (
+	"testing"
+
+	"github.com/jgardona/cmath"
+	"github.com/jgardona/cmath/points"
+	"github.com/jgardona/cmath/ranges"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerators(t *testing.T) {
+	uniform, _ := NewUniformGenerator(ranges.NewRange(-2.0, 6.0), 7)
+	gaussian, _ := NewGaussianGenerator(10, 2, 7)
+	exponential, _ := NewExponentialGenerator(4, 7)
+	standard := NewStandardGenerator(7)
+
+	generators := map[string]Generator{
+		"uniform":     uniform,
+		"gaussian":    gaussian,
+		"exponential": exponential,
+		"standard":    standard,
+	}
+
+	t.Run("constructors must validate parameters", func(t *testing.T) {
+		_, err := NewUniformGenerator(ranges.NewRange(1.0, 1.0), 0)
+		assert.ErrorIs(t, err, ErrBadRange)
+		_, err = NewUniformGenerator(ranges.NewRange(2.0, 1.0), 0)
+		assert.ErrorIs(t, err, ErrBadRange)
+		_, err = NewGaussianGenerator(0, 0, 0)
+		assert.ErrorIs(t, err, ErrBadStdDev)
+		_, err = NewExponentialGenerator(-1, 0)
+		assert.ErrorIs(t, err, ErrBadRate)
+	})
+
+	t.Run("generated numbers must match moments", func(t *testing.T) {
+		for name, g := range generators {
+			values := make([]float64, 50000)
+			Fill(g, values)
+
+			var sum, sqr float64
+			for _, e := range values {
+				sum += e
+				sqr += e * e
+			}
+			mean := sum / float64(len(values))
+			assert.InDelta(t, g.Mean(), mean, 0.05, name)
+			assert.InEpsilon(t, g.Variance(), sqr/float64(len(values))-mean*mean, 0.05, name)
+		}
+
+		for i := 0; i < 1000; i++ {
+			assert.True(t, uniform.Range().IsInside(uniform.Next()))
+			assert.GreaterOrEqual(t, exponential.Next(), 0.0)
+		}
+	})
+
+	t.Run("uniform numbers must be lesser than max", func(t *testing.T) {
+		// the spacing of float64 values near 1e16 is 2, so the sums round often
+		g, _ := NewUniformGenerator(ranges.NewRange(1e16, 1e16+4), 3)
+		for i := 0; i < 1000; i++ {
+			v := g.Next()
+			assert.GreaterOrEqual(t, v, 1e16)
+			assert.Less(t, v, 1e16+4)
+		}
+	})
+
+	t.Run("same seed must produce the same sequence", func(t *testing.T) {
+		for name, g := range generators {
+			g.SetSeed(99)
+			first := make([]float64, 10)
+			Fill(g, first)
+
+			g.SetSeed(99)
+			second := make([]float64, 10)
+			Fill(g, second)
+			assert.Equal(t, first, second, name)
+
+			g.SetSeed(100)
+			Fill(g, second)
+			assert.NotEqual(t, first, second, name)
+		}
+
+		other, _ := NewGaussianGenerator(10, 2, 99)
+		gaussian.SetSeed(99)
+		assert.Equal(t, other.Next(), gaussian.Next())
+	})
+
+	t.Run("fill vectors and points", func(t *testing.T) {
+		g, _ := NewUniformGenerator(ranges.NewRange(0.0, 10.0), 1)
+		vectors := make([]cmath.Vec3, 100)
+		FillVec3(g, vectors)
+		for _, v := range vectors {
+			assert.True(t, g.Range().IsInside(v.X()))
+			assert.True(t, g.Range().IsInside(v.Y()))
+			assert.True(t, g.Range().IsInside(v.Z()))
+		}
+		assert.NotEqual(t, vectors[0], vectors[1])
+
+		fpts := make([]points.Point[float64], 100)
+		FillPoints(g, fpts)
+		assert.NotEqual(t, fpts[0], fpts[1])
+
+		ipts := make([]points.Point[int], 100)
+		FillPoints(g, ipts)
+		for _, p := range ipts {
+			assert.True(t, p.X() >= 0 && p.X() < 10)
+			assert.True(t, p.Y() >= 0 && p.Y() < 10)
+		}
+	})
+}
+
+func BenchmarkGenerators(b *testing.B) {
+	uniform, _ := NewUniformGenerator(ranges.NewRange(0.0, 1.0), 1)
+	gaussian, _ := NewGaussianGenerator(0, 1, 1)
+
+	b.Run("uniform", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			uniform.Next()
+		}
+	})
+
+	b.Run("gaussian", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			gaussian.Next()
+		}
+	})
+}
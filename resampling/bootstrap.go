@@ -0,0 +1,169 @@
+package resampling
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+
+	"github.com/jgardona/cmath/distributions"
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/ranges"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// Bootstrap holds the statistic replicates computed over resamples drawn
+// with replacement, and builds confidence intervals from them.
+type Bootstrap struct {
+	estimate   float64
+	replicates []float64
+	// jackknife computes the jackknife over the original samples. It is
+	// only called by BCaInterval, as it costs one statistic per sample.
+	jackknife func() Jackknife
+}
+
+// Bootstrap resamples the samples with replacement and computes the
+// statistic over each resample.
+func (r Resampler) Bootstrap(samples []float64, stat Statistic) (Bootstrap, error) {
+	n := len(samples)
+	if n < 2 {
+		return Bootstrap{}, statistics.ErrTooFewSamples
+	}
+
+	replicates := r.run(func(rng *rand.Rand) float64 {
+		resample := make([]float64, n)
+		for i := range resample {
+			resample[i] = samples[rng.Intn(n)]
+		}
+		return stat(resample)
+	})
+	slices.Sort(replicates)
+	// the jackknife is computed later, so it must not see changes to samples
+	original := slices.Clone(samples)
+	return Bootstrap{
+		estimate:   stat(slices.Clone(samples)),
+		replicates: replicates,
+		jackknife: func() Jackknife {
+			j, _ := NewJackknife(original, stat)
+			return j
+		},
+	}, nil
+}
+
+// BootstrapHistogram resamples the histogram hits with replacement and
+// computes the statistic over each resampled histogram, which keeps the
+// same number of bins and hits.
+func (r Resampler) BootstrapHistogram(h histogram.Histogram, stat HistogramStatistic) (Bootstrap, error) {
+	if h.Total() < 2 {
+		return Bootstrap{}, statistics.ErrTooFewSamples
+	}
+
+	hits := expand(h)
+	bins := len(h.Values())
+	replicates := r.run(func(rng *rand.Rand) float64 {
+		counts := make([]int, bins)
+		for range hits {
+			counts[hits[rng.Intn(len(hits))]]++
+		}
+		return stat(histogram.NewHistogram(counts))
+	})
+	slices.Sort(replicates)
+	// the jackknife is computed later, so it must not see changes to h
+	original := histogram.NewHistogram(slices.Clone(h.Values()))
+	return Bootstrap{
+		estimate:   stat(h),
+		replicates: replicates,
+		jackknife: func() Jackknife {
+			j, _ := NewHistogramJackknife(original, stat)
+			return j
+		},
+	}, nil
+}
+
+// Estimate returns the statistic computed over the original samples.
+func (b Bootstrap) Estimate() float64 {
+	return b.estimate
+}
+
+// Replicates returns the statistic computed over each resample, sorted.
+func (b Bootstrap) Replicates() []float64 {
+	return b.replicates
+}
+
+// Bias returns the bootstrap estimate of the statistic's bias.
+func (b Bootstrap) Bias() float64 {
+	return statistics.SampleMean(b.replicates) - b.estimate
+}
+
+// StdError returns the bootstrap estimate of the statistic's standard error.
+func (b Bootstrap) StdError() float64 {
+	return statistics.SampleStdDev(b.replicates)
+}
+
+// Jackknife computes the jackknife estimate used to correct BCa intervals.
+// The jackknife is computed again on each call.
+func (b Bootstrap) Jackknife() Jackknife {
+	return b.jackknife()
+}
+
+// quantile returns the p quantile of the replicates.
+func (b Bootstrap) quantile(p float64) float64 {
+	q, _ := statistics.SampleQuantile(b.replicates, p, statistics.Linear)
+	return q
+}
+
+// PercentileInterval returns the interval between the replicates' quantiles
+// that leave (1 - confidence)/2 of the replicates in each tail.
+func (b Bootstrap) PercentileInterval(confidence float64) (ranges.Range[float64], error) {
+	if !(confidence > 0.0 && confidence < 1.0) {
+		return ranges.Range[float64]{}, ErrBadConfidence
+	}
+	alpha := (1.0 - confidence) / 2.0
+	return ranges.NewRange(b.quantile(alpha), b.quantile(1.0-alpha)), nil
+}
+
+// BasicInterval returns the basic (or reverse percentile) interval, which
+// reflects the percentile interval around the estimate.
+func (b Bootstrap) BasicInterval(confidence float64) (ranges.Range[float64], error) {
+	if !(confidence > 0.0 && confidence < 1.0) {
+		return ranges.Range[float64]{}, ErrBadConfidence
+	}
+	alpha := (1.0 - confidence) / 2.0
+	return ranges.NewRange(2.0*b.estimate-b.quantile(1.0-alpha), 2.0*b.estimate-b.quantile(alpha)), nil
+}
+
+// BCaInterval returns the bias corrected and accelerated interval, which
+// adjusts the percentile interval for the median bias of the replicates and
+// for the skewness of the statistic, estimated by the jackknife.
+//
+// # Note
+//
+// The jackknife is computed on each call, calculating the statistic once
+// for each sample (or histogram value). When all replicates fall on the same
+// side of the estimate, the bias correction is infinite and ErrDegenerate
+// is returned.
+func (b Bootstrap) BCaInterval(confidence float64) (ranges.Range[float64], error) {
+	if !(confidence > 0.0 && confidence < 1.0) {
+		return ranges.Range[float64]{}, ErrBadConfidence
+	}
+
+	below, _ := slices.BinarySearch(b.replicates, b.estimate)
+	proportion := float64(below) / float64(len(b.replicates))
+	if proportion == 0.0 || proportion == 1.0 {
+		return ranges.Range[float64]{}, ErrDegenerate
+	}
+
+	normal, _ := distributions.NewNormal(0, 1)
+	z0, _ := normal.Quantile(proportion)
+	a := b.jackknife().Acceleration()
+	adjust := func(p float64) float64 {
+		z, _ := normal.Quantile(p)
+		return normal.CDF(z0 + (z0+z)/(1.0-a*(z0+z)))
+	}
+
+	alpha := (1.0 - confidence) / 2.0
+	lower, upper := adjust(alpha), adjust(1.0-alpha)
+	if math.IsNaN(lower) || math.IsNaN(upper) {
+		return ranges.Range[float64]{}, ErrDegenerate
+	}
+	return ranges.NewRange(b.quantile(lower), b.quantile(upper)), nil
+}
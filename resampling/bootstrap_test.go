@@ -0,0 +1,146 @@
+package resampling
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/random"
+	"github.com/jgardona/cmath/ranges"
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBootstrap(t *testing.T) {
+	g, _ := random.NewGaussianGenerator(10, 2, 5)
+	samples := make([]float64, 200)
+	random.Fill(g, samples)
+	mean := func(s []float64) float64 { return statistics.SampleMean(s) }
+
+	t.Run("resampler must validate parameters", func(t *testing.T) {
+		_, err := NewResampler(0, 1, 1)
+		assert.ErrorIs(t, err, ErrBadResamples)
+		_, err = NewResampler(10, 1, 0)
+		assert.ErrorIs(t, err, ErrBadWorkers)
+
+		r, err := NewResampler(10, 3, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, 10, r.Resamples())
+		assert.Equal(t, 2, r.Workers())
+		assert.Equal(t, int64(3), r.Seed())
+	})
+
+	t.Run("results must not depend on the number of workers", func(t *testing.T) {
+		sequential, _ := NewResampler(1000, 7, 1)
+		parallel, _ := NewResampler(1000, 7, 4)
+		b1, err := sequential.Bootstrap(samples, mean)
+		assert.Nil(t, err)
+		b2, err := parallel.Bootstrap(samples, mean)
+		assert.Nil(t, err)
+		assert.Equal(t, b1.Replicates(), b2.Replicates())
+
+		other, _ := NewResampler(1000, 8, 1)
+		b3, _ := other.Bootstrap(samples, mean)
+		assert.NotEqual(t, b1.Replicates(), b3.Replicates())
+	})
+
+	t.Run("bootstrap of the mean", func(t *testing.T) {
+		r, _ := NewResampler(4000, 11, 4)
+		b, err := r.Bootstrap(samples, mean)
+		assert.Nil(t, err)
+		assert.Len(t, b.Replicates(), 4000)
+		assert.Equal(t, statistics.SampleMean(samples), b.Estimate())
+		assert.InDelta(t, 0.0, b.Bias(), 0.02)
+
+		expected := statistics.SampleStdDev(samples) / math.Sqrt(200)
+		assert.InEpsilon(t, expected, b.StdError(), 0.1)
+		assert.InEpsilon(t, expected, b.Jackknife().StdError(), 0.01)
+
+		intervals := map[string]func(float64) (ranges.Range[float64], error){
+			"percentile": b.PercentileInterval,
+			"basic":      b.BasicInterval,
+			"bca":        b.BCaInterval,
+		}
+		for name, interval := range intervals {
+			result, err := interval(0.95)
+			assert.Nil(t, err)
+			assert.True(t, result.IsInside(b.Estimate()), name)
+			// close to the normal approximation
+			assert.InDelta(t, b.Estimate()-1.96*expected, result.Min(), 0.05, name)
+			assert.InDelta(t, b.Estimate()+1.96*expected, result.Max(), 0.05, name)
+
+			_, err = interval(1)
+			assert.ErrorIs(t, err, ErrBadConfidence)
+		}
+	})
+
+	t.Run("basic interval reflects the percentile interval", func(t *testing.T) {
+		r, _ := NewResampler(500, 1, 1)
+		b, _ := r.Bootstrap(samples, func(s []float64) float64 { return statistics.SampleStdDev(s) })
+		percentile, _ := b.PercentileInterval(0.9)
+		basic, _ := b.BasicInterval(0.9)
+		assert.InDelta(t, 2*b.Estimate()-percentile.Max(), basic.Min(), 0.000001)
+		assert.InDelta(t, 2*b.Estimate()-percentile.Min(), basic.Max(), 0.000001)
+	})
+
+	t.Run("degenerate replicates", func(t *testing.T) {
+		r, _ := NewResampler(100, 1, 1)
+		b, err := r.Bootstrap(samples, func(s []float64) float64 { return 1.0 })
+		assert.Nil(t, err)
+		_, err = b.BCaInterval(0.95)
+		assert.ErrorIs(t, err, ErrDegenerate)
+
+		_, err = r.Bootstrap(nil, mean)
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+	})
+
+	t.Run("jackknife must only be computed by bca intervals", func(t *testing.T) {
+		calls := 0
+		counted := func(s []float64) float64 {
+			calls++
+			return statistics.SampleMean(s)
+		}
+		r, _ := NewResampler(100, 1, 1)
+		b, err := r.Bootstrap(samples, counted)
+		assert.Nil(t, err)
+		_, err = b.PercentileInterval(0.95)
+		assert.Nil(t, err)
+		_, err = b.BasicInterval(0.95)
+		assert.Nil(t, err)
+		assert.Equal(t, 101, calls)
+
+		_, err = b.BCaInterval(0.95)
+		assert.Nil(t, err)
+		assert.Equal(t, 101+len(samples)+1, calls)
+	})
+
+	t.Run("bootstrap of histograms", func(t *testing.T) {
+		h := histogram.NewHistogram([]int{0, 3, 10, 25, 30, 20, 8, 4})
+		r, _ := NewResampler(2000, 3, 2)
+		b, err := r.BootstrapHistogram(h, func(h histogram.Histogram) float64 { return h.Mean() })
+		assert.Nil(t, err)
+		assert.Equal(t, h.Mean(), b.Estimate())
+		assert.InEpsilon(t, h.StdDev()/math.Sqrt(float64(h.Total())), b.StdError(), 0.1)
+
+		interval, err := b.PercentileInterval(0.95)
+		assert.Nil(t, err)
+		assert.True(t, interval.IsInside(h.Mean()))
+	})
+}
+
+func BenchmarkBootstrap(b *testing.B) {
+	g, _ := random.NewGaussianGenerator(0, 1, 1)
+	samples := make([]float64, 1000)
+	random.Fill(g, samples)
+	mean := func(s []float64) float64 { return statistics.SampleMean(s) }
+
+	for _, workers := range []int{1, 4} {
+		r, _ := NewResampler(1000, 1, workers)
+		b.Run("workers "+strconv.Itoa(workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				r.Bootstrap(samples, mean)
+			}
+		})
+	}
+}
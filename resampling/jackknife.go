@@ -0,0 +1,113 @@
+package resampling
+
+import (
+	"math"
+	"slices"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// Jackknife is the leave one out estimate of a statistic.
+type Jackknife struct {
+	estimate     float64
+	bias         float64
+	stdErr       float64
+	acceleration float64
+}
+
+// newJackknife builds the jackknife estimates from the leave one out values,
+// where each value is repeated weights[i] times in n samples.
+func newJackknife(estimate float64, values []float64, weights []int, n int) Jackknife {
+	fn := float64(n)
+	var mean float64
+	for i, v := range values {
+		mean += float64(weights[i]) * v
+	}
+	mean /= fn
+
+	var sqr, cube float64
+	for i, v := range values {
+		d := mean - v
+		sqr += float64(weights[i]) * d * d
+		cube += float64(weights[i]) * d * d * d
+	}
+
+	j := Jackknife{
+		estimate: estimate,
+		bias:     (fn - 1.0) * (mean - estimate),
+		stdErr:   math.Sqrt((fn - 1.0) / fn * sqr),
+	}
+	if sqr > 0 {
+		j.acceleration = cube / (6.0 * math.Pow(sqr, 1.5))
+	}
+	return j
+}
+
+// NewJackknife computes the jackknife estimate of the statistic over samples.
+func NewJackknife(samples []float64, stat Statistic) (Jackknife, error) {
+	n := len(samples)
+	if n < 2 {
+		return Jackknife{}, statistics.ErrTooFewSamples
+	}
+
+	values := make([]float64, n)
+	weights := make([]int, n)
+	buffer := make([]float64, n-1)
+	for i := range samples {
+		copy(buffer, samples[:i])
+		copy(buffer[i:], samples[i+1:])
+		values[i] = stat(buffer)
+		weights[i] = 1
+	}
+	return newJackknife(stat(slices.Clone(samples)), values, weights, n), nil
+}
+
+// NewHistogramJackknife computes the jackknife estimate of the statistic over
+// a histogram, leaving out one hit at a time. Hits of the same value produce
+// the same histogram, so the statistic is computed once for each value.
+func NewHistogramJackknife(h histogram.Histogram, stat HistogramStatistic) (Jackknife, error) {
+	n := h.Total()
+	if n < 2 {
+		return Jackknife{}, statistics.ErrTooFewSamples
+	}
+
+	var values []float64
+	var weights []int
+	counts := slices.Clone(h.Values())
+	for v, count := range counts {
+		if count == 0 {
+			continue
+		}
+		counts[v]--
+		values = append(values, stat(histogram.NewHistogram(slices.Clone(counts))))
+		weights = append(weights, count)
+		counts[v]++
+	}
+	return newJackknife(stat(h), values, weights, n), nil
+}
+
+// Estimate returns the statistic computed over all samples.
+func (j Jackknife) Estimate() float64 {
+	return j.estimate
+}
+
+// Bias returns the jackknife estimate of the statistic's bias.
+func (j Jackknife) Bias() float64 {
+	return j.bias
+}
+
+// BiasCorrected returns the estimate with its bias removed.
+func (j Jackknife) BiasCorrected() float64 {
+	return j.estimate - j.bias
+}
+
+// StdError returns the jackknife estimate of the statistic's standard error.
+func (j Jackknife) StdError() float64 {
+	return j.stdErr
+}
+
+// Acceleration returns the acceleration used by BCa bootstrap intervals.
+func (j Jackknife) Acceleration() float64 {
+	return j.acceleration
+}
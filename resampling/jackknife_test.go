@@ -0,0 +1,49 @@
+package resampling
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJackknife(t *testing.T) {
+	samples := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	mean := func(s []float64) float64 { return statistics.SampleMean(s) }
+
+	t.Run("jackknife of the mean", func(t *testing.T) {
+		j, err := NewJackknife(samples, mean)
+		assert.Nil(t, err)
+		assert.InDelta(t, 5.0, j.Estimate(), 0.000001)
+		assert.InDelta(t, 0.0, j.Bias(), 0.000001)
+		assert.InDelta(t, statistics.SampleStdDev(samples)/math.Sqrt(8), j.StdError(), 0.000001)
+
+		_, err = NewJackknife(samples[:1], mean)
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+	})
+
+	t.Run("bias corrected population variance is the sample variance", func(t *testing.T) {
+		variance := func(s []float64) float64 { return statistics.PopulationVariance(s) }
+		j, err := NewJackknife(samples, variance)
+		assert.Nil(t, err)
+		assert.InDelta(t, 4.0, j.Estimate(), 0.000001)
+		assert.InDelta(t, statistics.SampleVariance(samples), j.BiasCorrected(), 0.000001)
+	})
+
+	t.Run("histogram jackknife must match the samples jackknife", func(t *testing.T) {
+		h := histogram.NewHistogram([]int{0, 0, 1, 0, 3, 2, 0, 1, 0, 1})
+		j, err := NewHistogramJackknife(h, func(h histogram.Histogram) float64 { return h.Mean() })
+		assert.Nil(t, err)
+
+		expected, _ := NewJackknife(samples, mean)
+		assert.InDelta(t, expected.Estimate(), j.Estimate(), 0.000001)
+		assert.InDelta(t, expected.Bias(), j.Bias(), 0.000001)
+		assert.InDelta(t, expected.StdError(), j.StdError(), 0.000001)
+		assert.InDelta(t, expected.Acceleration(), j.Acceleration(), 0.000001)
+
+		_, err = NewHistogramJackknife(histogram.NewHistogram([]int{0, 1}), func(h histogram.Histogram) float64 { return h.Mean() })
+		assert.ErrorIs(t, err, statistics.ErrTooFewSamples)
+	})
+}
@@ -0,0 +1,128 @@
+package resampling
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/hypothesis"
+	"github.com/jgardona/cmath/statistics"
+)
+
+// Permutation is the outcome of a permutation test.
+type Permutation struct {
+	statistic float64
+	pValue    float64
+}
+
+// Statistic returns the statistic computed over the original samples.
+func (p Permutation) Statistic() float64 {
+	return p.statistic
+}
+
+// PValue returns the proportion of permutations with a statistic at least
+// as extreme as the observed one, counting the observed samples.
+func (p Permutation) PValue() float64 {
+	return p.pValue
+}
+
+// Reject checks if the null hypothesis is rejected at the significance level alpha.
+func (p Permutation) Reject(alpha float64) bool {
+	return p.pValue < alpha
+}
+
+// permutation counts the replicates at least as extreme as the observed statistic.
+func permutation(observed float64, replicates []float64, alternative hypothesis.Alternative) (Permutation, error) {
+	var extreme func(float64) bool
+	switch alternative {
+	case hypothesis.TwoSided:
+		extreme = func(v float64) bool { return math.Abs(v) >= math.Abs(observed) }
+	case hypothesis.Less:
+		extreme = func(v float64) bool { return v <= observed }
+	case hypothesis.Greater:
+		extreme = func(v float64) bool { return v >= observed }
+	default:
+		return Permutation{}, hypothesis.ErrBadAlternative
+	}
+
+	count := 0
+	for _, v := range replicates {
+		if extreme(v) {
+			count++
+		}
+	}
+	p := float64(count+1) / float64(len(replicates)+1)
+	return Permutation{statistic: observed, pValue: p}, nil
+}
+
+// validAlternative checks if the alternative hypothesis is known.
+func validAlternative(alternative hypothesis.Alternative) bool {
+	return alternative == hypothesis.TwoSided || alternative == hypothesis.Less || alternative == hypothesis.Greater
+}
+
+// PermutationTest tests if two samples come from the same distribution,
+// randomly reassigning the pooled samples to each group.
+//
+// # Note
+//
+// Two sided tests compare absolute values, so the statistic must be
+// centered at zero under the null hypothesis, like a difference of means.
+func (r Resampler) PermutationTest(a, b []float64, stat TwoSampleStatistic, alternative hypothesis.Alternative) (Permutation, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return Permutation{}, statistics.ErrEmptySamples
+	}
+	if !validAlternative(alternative) {
+		return Permutation{}, hypothesis.ErrBadAlternative
+	}
+
+	pooled := append(slices.Clone(a), b...)
+	replicates := r.run(func(rng *rand.Rand) float64 {
+		shuffled := slices.Clone(pooled)
+		rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		return stat(shuffled[:len(a)], shuffled[len(a):])
+	})
+	return permutation(stat(slices.Clone(a), slices.Clone(b)), replicates, alternative)
+}
+
+// PermutationTestHistogram tests if two histograms come from the same
+// distribution, randomly reassigning the pooled hits to each histogram.
+// Both permuted histograms have the number of bins of the largest one.
+//
+// # Note
+//
+// Two sided tests compare absolute values, so the statistic must be
+// centered at zero under the null hypothesis.
+func (r Resampler) PermutationTestHistogram(a, b histogram.Histogram, stat TwoHistogramStatistic, alternative hypothesis.Alternative) (Permutation, error) {
+	if a.Total() == 0 || b.Total() == 0 {
+		return Permutation{}, statistics.ErrEmptySamples
+	}
+	if !validAlternative(alternative) {
+		return Permutation{}, hypothesis.ErrBadAlternative
+	}
+
+	bins := max(len(a.Values()), len(b.Values()))
+	pooled := append(expand(a), expand(b)...)
+	na := a.Total()
+	replicates := r.run(func(rng *rand.Rand) float64 {
+		shuffled := slices.Clone(pooled)
+		rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		ca, cb := make([]int, bins), make([]int, bins)
+		for _, v := range shuffled[:na] {
+			ca[v]++
+		}
+		for _, v := range shuffled[na:] {
+			cb[v]++
+		}
+		return stat(histogram.NewHistogram(ca), histogram.NewHistogram(cb))
+	})
+	observed := stat(pad(a, bins), pad(b, bins))
+	return permutation(observed, replicates, alternative)
+}
+
+// pad returns a histogram with the given number of bins, appending empty bins.
+func pad(h histogram.Histogram, bins int) histogram.Histogram {
+	values := make([]int, bins)
+	copy(values, h.Values())
+	return histogram.NewHistogram(values)
+}
@@ -0,0 +1,63 @@
+package resampling
+
+import (
+	"testing"
+
+	"github.com/jgardona/cmath/histogram"
+	"github.com/jgardona/cmath/hypothesis"
+	"github.com/jgardona/cmath/statistics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermutation(t *testing.T) {
+	a := []float64{12.1, 11.8, 12.6, 12.9, 12.3, 11.7, 12.4, 12.0}
+	b := []float64{11.2, 11.9, 11.5, 11.0, 11.8, 11.4, 11.6, 12.1, 10.9, 11.3}
+	difference := func(a, b []float64) float64 {
+		return statistics.SampleMean(a) - statistics.SampleMean(b)
+	}
+	r, _ := NewResampler(5000, 13, 4)
+
+	t.Run("permutation test over samples", func(t *testing.T) {
+		result, err := r.PermutationTest(a, b, difference, hypothesis.TwoSided)
+		assert.Nil(t, err)
+		assert.InDelta(t, difference(a, b), result.Statistic(), 0.000001)
+		assert.True(t, result.Reject(0.01))
+		// close to the equivalent t-test
+		assert.InDelta(t, 0.001, result.PValue(), 0.002)
+
+		greater, _ := r.PermutationTest(a, b, difference, hypothesis.Greater)
+		less, _ := r.PermutationTest(a, b, difference, hypothesis.Less)
+		assert.Less(t, greater.PValue(), result.PValue()+0.000001)
+		assert.Greater(t, less.PValue(), 0.99)
+
+		same, _ := r.PermutationTest(a[:4], a[4:], difference, hypothesis.TwoSided)
+		assert.False(t, same.Reject(0.05))
+
+		_, err = r.PermutationTest(a, nil, difference, hypothesis.TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+		_, err = r.PermutationTest(a, b, difference, hypothesis.Alternative(9))
+		assert.ErrorIs(t, err, hypothesis.ErrBadAlternative)
+	})
+
+	t.Run("permutation test over histograms", func(t *testing.T) {
+		difference := func(a, b histogram.Histogram) float64 { return a.Mean() - b.Mean() }
+		low := histogram.NewHistogram([]int{5, 10, 8, 2})
+		high := histogram.NewHistogram([]int{1, 3, 8, 10, 6})
+
+		result, err := r.PermutationTestHistogram(low, high, difference, hypothesis.Less)
+		assert.Nil(t, err)
+		assert.InDelta(t, low.Mean()-high.Mean(), result.Statistic(), 0.000001)
+		assert.True(t, result.Reject(0.01))
+
+		// permuted histograms keep the same number of bins
+		bins := func(a, b histogram.Histogram) float64 {
+			assert.Equal(t, len(a.Values()), len(b.Values()))
+			return 0
+		}
+		_, err = r.PermutationTestHistogram(low, high, bins, hypothesis.TwoSided)
+		assert.Nil(t, err)
+
+		_, err = r.PermutationTestHistogram(low, histogram.NewHistogram([]int{0}), difference, hypothesis.TwoSided)
+		assert.ErrorIs(t, err, statistics.ErrEmptySamples)
+	})
+}
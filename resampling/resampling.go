@@ -0,0 +1,136 @@
+// # Resampling
+//
+// This package contains resampling methods to estimate the uncertainty of
+// any statistic: bootstrap confidence intervals, jackknife estimates and
+// permutation tests, over samples or histograms.
+package resampling
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+
+	"github.com/jgardona/cmath/histogram"
+)
+
+var (
+	ErrBadResamples  = errors.New("number of resamples must be greater than or equal to 1")
+	ErrBadWorkers    = errors.New("number of workers must be greater than or equal to 1")
+	ErrBadConfidence = errors.New("confidence level must be in the interval (0, 1)")
+	ErrDegenerate    = errors.New("bootstrap replicates are degenerate")
+)
+
+// blockSize is the number of resamples drawn from the same random source.
+// Blocks are seeded upfront, so results don't depend on the number of workers.
+const blockSize = 64
+
+// Statistic calculates a value from samples.
+type Statistic func(samples []float64) float64
+
+// HistogramStatistic calculates a value from a histogram.
+type HistogramStatistic func(h histogram.Histogram) float64
+
+// TwoSampleStatistic calculates a value comparing two samples.
+type TwoSampleStatistic func(a, b []float64) float64
+
+// TwoHistogramStatistic calculates a value comparing two histograms.
+type TwoHistogramStatistic func(a, b histogram.Histogram) float64
+
+// Resampler draws random resamples with a seeded generator.
+//
+// # Note
+//
+// The same seed always produces the same results, regardless of the number
+// of workers. When using more than one worker, the statistic functions are
+// called concurrently and must be safe for concurrent use.
+//
+// Resamples are drawn with math/rand instead of the random package generators,
+// since they need uniform integer indexes and one independent source for each
+// block of resamples, seeded from the resampler's seed.
+type Resampler struct {
+	resamples int
+	workers   int
+	seed      int64
+}
+
+// NewResampler instantiates a resampler given the number of resamples,
+// the seed and the number of goroutines used to compute them.
+func NewResampler(resamples int, seed int64, workers int) (Resampler, error) {
+	if resamples < 1 {
+		return Resampler{}, ErrBadResamples
+	}
+	if workers < 1 {
+		return Resampler{}, ErrBadWorkers
+	}
+	return Resampler{resamples: resamples, workers: workers, seed: seed}, nil
+}
+
+// Resamples returns the number of resamples.
+func (r Resampler) Resamples() int {
+	return r.resamples
+}
+
+// Workers returns the number of goroutines used to compute the resamples.
+func (r Resampler) Workers() int {
+	return r.workers
+}
+
+// Seed returns the seed of the random generator.
+func (r Resampler) Seed() int64 {
+	return r.seed
+}
+
+// run calls compute once for each resample and returns the results.
+func (r Resampler) run(compute func(rng *rand.Rand) float64) []float64 {
+	blocks := (r.resamples + blockSize - 1) / blockSize
+	master := rand.New(rand.NewSource(r.seed))
+	seeds := make([]int64, blocks)
+	for i := range seeds {
+		seeds[i] = master.Int63()
+	}
+
+	results := make([]float64, r.resamples)
+	runBlock := func(b int) {
+		rng := rand.New(rand.NewSource(seeds[b]))
+		for i := b * blockSize; i < min((b+1)*blockSize, r.resamples); i++ {
+			results[i] = compute(rng)
+		}
+	}
+
+	if r.workers == 1 {
+		for b := 0; b < blocks; b++ {
+			runBlock(b)
+		}
+		return results
+	}
+
+	queue := make(chan int, blocks)
+	for b := 0; b < blocks; b++ {
+		queue <- b
+	}
+	close(queue)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(r.workers, blocks); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range queue {
+				runBlock(b)
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// expand returns one value for each hit of the histogram.
+func expand(h histogram.Histogram) []int {
+	values := make([]int, 0, h.Total())
+	for v, count := range h.Values() {
+		for i := 0; i < count; i++ {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,297 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/jgardona/cmath/constraints"
+	"github.com/jgardona/cmath/points"
+)
+
+var (
+	ErrSeriesMismatch = errors.New("series must have the same length")
+	ErrTooFewSamples  = errors.New("at least two samples are required")
+	ErrNoVariance     = errors.New("series must not be constant")
+)
+
+// validatePair checks if two series can be compared.
+func validatePair(x, y []float64) error {
+	if len(x) != len(y) {
+		return ErrSeriesMismatch
+	}
+	if len(x) < 2 {
+		return ErrTooFewSamples
+	}
+	return nil
+}
+
+// Coordinates splits points in the series of their x and y coordinates.
+func Coordinates[T constraints.Numbers](pts []points.Point[T]) ([]float64, []float64) {
+	x, y := make([]float64, len(pts)), make([]float64, len(pts))
+	for i, p := range pts {
+		x[i], y[i] = float64(p.X()), float64(p.Y())
+	}
+	return x, y
+}
+
+// Covariance calculates the sample covariance of two series, dividing by n-1.
+func Covariance(x, y []float64) (float64, error) {
+	if err := validatePair(x, y); err != nil {
+		return 0.0, err
+	}
+	mx, my := SampleMean(x), SampleMean(y)
+	var sum float64
+	for i := range x {
+		sum += (x[i] - mx) * (y[i] - my)
+	}
+	return sum / float64(len(x)-1), nil
+}
+
+// Pearson calculates the pearson correlation coefficient of two series,
+// in the interval [-1, 1].
+func Pearson(x, y []float64) (float64, error) {
+	if err := validatePair(x, y); err != nil {
+		return 0.0, err
+	}
+	mx, my := SampleMean(x), SampleMean(y)
+	var sxy, sxx, syy float64
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 || syy == 0 {
+		return 0.0, ErrNoVariance
+	}
+	return sxy / math.Sqrt(sxx*syy), nil
+}
+
+// Ranks returns the rank of each sample, starting at 1. Tied samples
+// receive the average of their ranks.
+func Ranks(samples []float64) []float64 {
+	n := len(samples)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return samples[order[a]] < samples[order[b]] })
+
+	result := make([]float64, n)
+	for i := 0; i < n; {
+		j := i
+		for j+1 < n && samples[order[j+1]] == samples[order[i]] {
+			j++
+		}
+		rank := float64(i+j)/2.0 + 1.0
+		for k := i; k <= j; k++ {
+			result[order[k]] = rank
+		}
+		i = j + 1
+	}
+	return result
+}
+
+// Spearman calculates the spearman rank correlation coefficient of two
+// series, i.e. the pearson correlation of their ranks.
+func Spearman(x, y []float64) (float64, error) {
+	if err := validatePair(x, y); err != nil {
+		return 0.0, err
+	}
+	return Pearson(Ranks(x), Ranks(y))
+}
+
+// Kendall calculates the kendall tau-b rank correlation coefficient of two
+// series, which accounts for ties. The calculation is O(n²).
+func Kendall(x, y []float64) (float64, error) {
+	if err := validatePair(x, y); err != nil {
+		return 0.0, err
+	}
+
+	var concordant, discordant, tiesX, tiesY float64
+	for i := 0; i < len(x); i++ {
+		for j := i + 1; j < len(x); j++ {
+			dx, dy := x[i]-x[j], y[i]-y[j]
+			switch {
+			case dx == 0 && dy == 0:
+			case dx == 0:
+				tiesX++
+			case dy == 0:
+				tiesY++
+			case (dx > 0) == (dy > 0):
+				concordant++
+			default:
+				discordant++
+			}
+		}
+	}
+
+	denominator := math.Sqrt((concordant + discordant + tiesX) * (concordant + discordant + tiesY))
+	if denominator == 0 {
+		return 0.0, ErrNoVariance
+	}
+	return (concordant - discordant) / denominator, nil
+}
+
+// validateSeries checks if all series have the same length.
+func validateSeries(series [][]float64) error {
+	if len(series) == 0 {
+		return ErrEmptySamples
+	}
+	for _, s := range series {
+		if len(s) != len(series[0]) {
+			return ErrSeriesMismatch
+		}
+	}
+	if len(series[0]) < 2 {
+		return ErrTooFewSamples
+	}
+	return nil
+}
+
+// CovarianceMatrix calculates the sample covariance between every pair of
+// series. The element [i][j] is the covariance of series i and j.
+func CovarianceMatrix(series [][]float64) ([][]float64, error) {
+	if err := validateSeries(series); err != nil {
+		return nil, err
+	}
+	return pairMatrix(series, func(x []float64) float64 {
+		v, _ := Covariance(x, x)
+		return v
+	}, Covariance)
+}
+
+// CorrelationMatrix calculates the pearson correlation between every pair of
+// series. The element [i][j] is the correlation of series i and j.
+//
+// # Note
+//
+// The diagonal is 1 without calculating the correlation of a series with
+// itself, or NaN if the series is constant. ErrNoVariance is returned only
+// when a constant series is paired with another series.
+func CorrelationMatrix(series [][]float64) ([][]float64, error) {
+	if err := validateSeries(series); err != nil {
+		return nil, err
+	}
+	return pairMatrix(series, func(x []float64) float64 {
+		if v, _ := Covariance(x, x); v == 0 {
+			return math.NaN()
+		}
+		return 1.0
+	}, Pearson)
+}
+
+// pairMatrix builds a symmetric matrix applying diagonal to every series,
+// and f to every pair of distinct series.
+func pairMatrix(series [][]float64, diagonal func(x []float64) float64, f func(x, y []float64) (float64, error)) ([][]float64, error) {
+	n := len(series)
+	result := make([][]float64, n)
+	for i := range result {
+		result[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		result[i][i] = diagonal(series[i])
+		for j := i + 1; j < n; j++ {
+			v, err := f(series[i], series[j])
+			if err != nil {
+				return nil, err
+			}
+			result[i][j], result[j][i] = v, v
+		}
+	}
+	return result, nil
+}
+
+// Regression is the result of a simple linear regression y = slope*x + intercept.
+type Regression struct {
+	slope     float64
+	intercept float64
+	r2        float64
+	residuals []float64
+}
+
+// LinearRegression fits a line to the series using ordinary least squares.
+func LinearRegression(x, y []float64) (Regression, error) {
+	if err := validatePair(x, y); err != nil {
+		return Regression{}, err
+	}
+
+	mx, my := SampleMean(x), SampleMean(y)
+	var sxy, sxx, syy float64
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return Regression{}, ErrNoVariance
+	}
+
+	r := Regression{slope: sxy / sxx}
+	r.intercept = my - r.slope*mx
+	r.residuals = make([]float64, len(x))
+	var sse float64
+	for i := range x {
+		r.residuals[i] = y[i] - r.Predict(x[i])
+		sse += r.residuals[i] * r.residuals[i]
+	}
+
+	// a constant y is perfectly fitted by an horizontal line
+	r.r2 = 1.0
+	if syy != 0 {
+		r.r2 = 1.0 - sse/syy
+	}
+	return r, nil
+}
+
+// Slope returns the regression line slope.
+func (r Regression) Slope() float64 {
+	return r.slope
+}
+
+// Intercept returns the regression line intercept.
+func (r Regression) Intercept() float64 {
+	return r.intercept
+}
+
+// RSquared returns the coefficient of determination.
+func (r Regression) RSquared() float64 {
+	return r.r2
+}
+
+// Residuals returns the difference between each observed y and its prediction.
+func (r Regression) Residuals() []float64 {
+	return r.residuals
+}
+
+// Predict returns the y value of the regression line at x.
+func (r Regression) Predict(x float64) float64 {
+	return r.slope*x + r.intercept
+}
+
+// CovariancePoints calculates the sample covariance of the points coordinates.
+func CovariancePoints[T constraints.Numbers](pts []points.Point[T]) (float64, error) {
+	return Covariance(Coordinates(pts))
+}
+
+// PearsonPoints calculates the pearson correlation of the points coordinates.
+func PearsonPoints[T constraints.Numbers](pts []points.Point[T]) (float64, error) {
+	return Pearson(Coordinates(pts))
+}
+
+// SpearmanPoints calculates the spearman correlation of the points coordinates.
+func SpearmanPoints[T constraints.Numbers](pts []points.Point[T]) (float64, error) {
+	return Spearman(Coordinates(pts))
+}
+
+// KendallPoints calculates the kendall correlation of the points coordinates.
+func KendallPoints[T constraints.Numbers](pts []points.Point[T]) (float64, error) {
+	return Kendall(Coordinates(pts))
+}
+
+// LinearRegressionPoints fits a line to the points using ordinary least squares.
+func LinearRegressionPoints[T constraints.Numbers](pts []points.Point[T]) (Regression, error) {
+	return LinearRegression(Coordinates(pts))
+}
@@ -0,0 +1,152 @@
+package statistics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jgardona/cmath/points"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{2, 4, 5, 4, 5}
+	reversed := []float64{5, 4, 3, 2, 1}
+	constant := []float64{3, 3, 3, 3, 3}
+
+	t.Run("covariance", func(t *testing.T) {
+		result, err := Covariance(x, y)
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.5, result, 0.0001)
+
+		result, err = Covariance(x, x)
+		assert.Nil(t, err)
+		assert.InDelta(t, SampleVariance(x), result, 0.0001)
+
+		_, err = Covariance(x, y[:3])
+		assert.ErrorIs(t, err, ErrSeriesMismatch)
+		_, err = Covariance(x[:1], y[:1])
+		assert.ErrorIs(t, err, ErrTooFewSamples)
+	})
+
+	t.Run("pearson", func(t *testing.T) {
+		result, err := Pearson(x, y)
+		assert.Nil(t, err)
+		assert.InDelta(t, 6/math.Sqrt(60), result, 0.0001)
+
+		result, err = Pearson(x, reversed)
+		assert.Nil(t, err)
+		assert.InDelta(t, -1.0, result, 0.0001)
+
+		_, err = Pearson(x, constant)
+		assert.ErrorIs(t, err, ErrNoVariance)
+	})
+
+	t.Run("spearman", func(t *testing.T) {
+		assert.Equal(t, []float64{1, 2.5, 4.5, 2.5, 4.5}, Ranks(y))
+
+		result, err := Spearman(x, y)
+		assert.Nil(t, err)
+		assert.InDelta(t, 7/math.Sqrt(90), result, 0.0001)
+
+		// monotonic but non linear relations are perfectly ranked
+		result, err = Spearman(x, []float64{1, 8, 27, 64, 125})
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.0, result, 0.0001)
+
+		_, err = Spearman(x, constant)
+		assert.ErrorIs(t, err, ErrNoVariance)
+	})
+
+	t.Run("kendall", func(t *testing.T) {
+		result, err := Kendall(x, y)
+		assert.Nil(t, err)
+		assert.InDelta(t, 6/math.Sqrt(80), result, 0.0001)
+
+		result, err = Kendall(x, reversed)
+		assert.Nil(t, err)
+		assert.InDelta(t, -1.0, result, 0.0001)
+
+		_, err = Kendall(x, constant)
+		assert.ErrorIs(t, err, ErrNoVariance)
+		_, err = Kendall(x, y[:2])
+		assert.ErrorIs(t, err, ErrSeriesMismatch)
+	})
+
+	t.Run("covariance and correlation matrices", func(t *testing.T) {
+		series := [][]float64{x, y, reversed}
+
+		cov, err := CovarianceMatrix(series)
+		assert.Nil(t, err)
+		assert.InDelta(t, 2.5, cov[0][0], 0.0001)
+		assert.InDelta(t, 1.5, cov[0][1], 0.0001)
+		assert.InDelta(t, 1.5, cov[1][0], 0.0001)
+		assert.InDelta(t, -2.5, cov[2][0], 0.0001)
+
+		corr, err := CorrelationMatrix(series)
+		assert.Nil(t, err)
+		for i := range corr {
+			assert.Equal(t, 1.0, corr[i][i])
+		}
+		assert.InDelta(t, -corr[0][1], corr[2][1], 0.0001)
+
+		_, err = CovarianceMatrix(nil)
+		assert.ErrorIs(t, err, ErrEmptySamples)
+		_, err = CorrelationMatrix([][]float64{x, y[:4]})
+		assert.ErrorIs(t, err, ErrSeriesMismatch)
+		_, err = CorrelationMatrix([][]float64{x, constant})
+		assert.ErrorIs(t, err, ErrNoVariance)
+		corr, err = CorrelationMatrix([][]float64{constant})
+		assert.Nil(t, err)
+		assert.True(t, math.IsNaN(corr[0][0]))
+	})
+
+	t.Run("linear regression", func(t *testing.T) {
+		r, err := LinearRegression(x, y)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.6, r.Slope(), 0.0001)
+		assert.InDelta(t, 2.2, r.Intercept(), 0.0001)
+		assert.InDelta(t, 0.6, r.RSquared(), 0.0001)
+		assert.InDelta(t, 8.2, r.Predict(10), 0.0001)
+		assert.InDeltaSlice(t, []float64{-0.8, 0.6, 1.0, -0.6, -0.2}, r.Residuals(), 0.0001)
+
+		r, err = LinearRegression(x, constant)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.0, r.Slope(), 0.0001)
+		assert.InDelta(t, 1.0, r.RSquared(), 0.0001)
+
+		_, err = LinearRegression(constant, x)
+		assert.ErrorIs(t, err, ErrNoVariance)
+	})
+
+	t.Run("points variants", func(t *testing.T) {
+		pts := make([]points.Point[int], len(x))
+		for i := range x {
+			pts[i] = points.NewPoint(int(x[i]), int(y[i]))
+		}
+
+		px, py := Coordinates(pts)
+		assert.Equal(t, x, px)
+		assert.Equal(t, y, py)
+
+		cov, err := CovariancePoints(pts)
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.5, cov, 0.0001)
+
+		pearson, err := PearsonPoints(pts)
+		assert.Nil(t, err)
+		assert.InDelta(t, 6/math.Sqrt(60), pearson, 0.0001)
+
+		spearman, err := SpearmanPoints(pts)
+		assert.Nil(t, err)
+		assert.InDelta(t, 7/math.Sqrt(90), spearman, 0.0001)
+
+		kendall, err := KendallPoints(pts)
+		assert.Nil(t, err)
+		assert.InDelta(t, 6/math.Sqrt(80), kendall, 0.0001)
+
+		r, err := LinearRegressionPoints(pts)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.6, r.Slope(), 0.0001)
+	})
+}
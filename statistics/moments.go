@@ -0,0 +1,152 @@
+package statistics
+
+import (
+	"math"
+
+	"github.com/jgardona/cmath"
+)
+
+// RawMoment calculates the k-th raw moment, i.e. the mean of i^k.
+//
+// The input array is treated as histogram, i.e. its
+// indexes are treated as velues of stochastic function, but
+// array values are threated as probabilities (total amount of hits).
+func RawMoment(histogram []int, k int) float64 {
+	return momentAbout(histogram, 0.0, k)
+}
+
+// CentralMoment calculates the k-th central moment, i.e. the mean of (i - mean)^k.
+//
+// The input array is treated as histogram, i.e. its
+// indexes are treated as velues of stochastic function, but
+// array values are threated as probabilities (total amount of hits).
+func CentralMoment(histogram []int, k int) float64 {
+	return momentAbout(histogram, Mean(histogram), k)
+}
+
+// momentAbout calculates the k-th moment about the given center.
+func momentAbout(histogram []int, center float64, k int) float64 {
+	var (
+		total  int     = cmath.Sum(histogram...)
+		moment float64 = 0.0
+	)
+
+	if total == 0 {
+		return 0.0
+	}
+	for i, e := range histogram {
+		if e != 0 {
+			moment += cmath.Pow(float64(i)-center, k) * float64(e)
+		}
+	}
+	return moment / float64(total)
+}
+
+// Skewness calculates the skewness m3/m2^1.5, where mk is the k-th central moment.
+// Returns zero for histograms without dispersion.
+//
+// The input array is treated as histogram, i.e. its
+// indexes are treated as velues of stochastic function, but
+// array values are threated as probabilities (total amount of hits).
+func Skewness(histogram []int) float64 {
+	m2 := CentralMoment(histogram, 2)
+	if m2 == 0 {
+		return 0.0
+	}
+	return CentralMoment(histogram, 3) / math.Pow(m2, 1.5)
+}
+
+// Kurtosis calculates the excess kurtosis m4/m2² - 3, where mk is the k-th
+// central moment. Returns zero for histograms without dispersion.
+//
+// The input array is treated as histogram, i.e. its
+// indexes are treated as velues of stochastic function, but
+// array values are threated as probabilities (total amount of hits).
+func Kurtosis(histogram []int) float64 {
+	m2 := CentralMoment(histogram, 2)
+	if m2 == 0 {
+		return 0.0
+	}
+	return CentralMoment(histogram, 4)/(m2*m2) - 3.0
+}
+
+// Quantile calculates the p quantile, with p in the interval [0, 1].
+//
+// The input array is treated as histogram, i.e. its
+// indexes are treated as velues of stochastic function, but
+// array values are threated as probabilities (total amount of hits).
+//
+// The quantile is calculated accumulating histogram's values starting
+// from the left point until the sum reaches p of histogram's sum, as
+// Median does for 50%.
+func Quantile(histogram []int, p float64) (int, error) {
+	if !(p >= 0.0 && p <= 1.0) {
+		return 0, ErrBadProbability
+	}
+
+	var total int = cmath.Sum(histogram...)
+	if total == 0 {
+		return 0, nil
+	}
+
+	h := int(float64(total) * p)
+	quantile := 0
+	v := 0
+	for ; quantile < len(histogram)-1; quantile++ {
+		v += histogram[quantile]
+		if v >= h && v > 0 {
+			break
+		}
+	}
+	return quantile, nil
+}
+
+// Percentile calculates the percentile, with percent in the interval [0, 100].
+// See Quantile.
+func Percentile(histogram []int, percent float64) (int, error) {
+	return Quantile(histogram, percent/100.0)
+}
+
+// IQR calculates the interquartile range, i.e. the distance between the
+// third and the first quartiles.
+//
+// The input array is treated as histogram, i.e. its
+// indexes are treated as velues of stochastic function, but
+// array values are threated as probabilities (total amount of hits).
+func IQR(histogram []int) int {
+	q1, _ := Quantile(histogram, 0.25)
+	q3, _ := Quantile(histogram, 0.75)
+	return q3 - q1
+}
+
+// Modes calculates all mode values, i.e. every peak of the histogram.
+//
+// The input array is treated as histogram, i.e. its
+// indexes are treated as velues of stochastic function, but
+// array values are threated as probabilities (total amount of hits).
+//
+// # Note
+//
+// A peak is a non empty index whose hits are greater than the hits of its
+// neighbours. Flat peaks, spanning several indexes with the same hits, are
+// reported by their first index. Use Mode for the highest peak only.
+func Modes(histogram []int) []int {
+	var modes []int
+	n := len(histogram)
+	for i := 0; i < n; i++ {
+		e := histogram[i]
+		if e == 0 || (i > 0 && histogram[i-1] >= e) {
+			continue
+		}
+		// skips a flat peak, checking the index after it
+		j := i
+		for j+1 < n && histogram[j+1] == e {
+			j++
+		}
+		if j+1 == n || histogram[j+1] < e {
+			modes = append(modes, i)
+		}
+		i = j
+	}
+	return modes
+}
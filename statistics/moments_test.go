@@ -0,0 +1,65 @@
+package statistics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramMoments(t *testing.T) {
+	// the histogram of the samples 2, 4, 4, 4, 5, 5, 7, 9
+	histogram := []int{0, 0, 1, 0, 3, 2, 0, 1, 0, 1}
+	histogramNormal := []int{1, 3, 6, 9, 6, 3, 1}
+	histogramEmpty := []int{}
+
+	t.Run("raw and central moments", func(t *testing.T) {
+		assert.InDelta(t, 5.0, RawMoment(histogram, 1), 0.0001)
+		assert.InDelta(t, 29.0, RawMoment(histogram, 2), 0.0001)
+		assert.InDelta(t, 4.0, CentralMoment(histogram, 2), 0.0001)
+		assert.InDelta(t, 0.0, CentralMoment(histogram, 1), 0.0001)
+		assert.InDelta(t, 1.0, CentralMoment(histogram, 0), 0.0001)
+		assert.Equal(t, 0.0, RawMoment(histogramEmpty, 2))
+	})
+
+	t.Run("skewness and kurtosis", func(t *testing.T) {
+		assert.InDelta(t, 0.65625, Skewness(histogram), 0.0001)
+		assert.InDelta(t, -0.21875, Kurtosis(histogram), 0.0001)
+		assert.InDelta(t, 0.0, Skewness(histogramNormal), 0.0001)
+		assert.Equal(t, 0.0, Skewness([]int{0, 5}))
+		assert.Equal(t, 0.0, Kurtosis(histogramEmpty))
+	})
+
+	t.Run("quantiles and percentiles", func(t *testing.T) {
+		result, err := Quantile(histogramNormal, 0.5)
+		assert.Nil(t, err)
+		assert.Equal(t, Median(histogramNormal), result)
+
+		result, _ = Quantile(histogram, 0.0)
+		assert.Equal(t, 2, result)
+		result, _ = Quantile(histogram, 1.0)
+		assert.Equal(t, 9, result)
+		result, _ = Percentile(histogram, 25)
+		assert.Equal(t, 4, result)
+		result, _ = Quantile(histogramEmpty, 0.5)
+		assert.Equal(t, 0, result)
+
+		_, err = Quantile(histogram, -0.1)
+		assert.ErrorIs(t, err, ErrBadProbability)
+		_, err = Percentile(histogram, 101)
+		assert.ErrorIs(t, err, ErrBadProbability)
+	})
+
+	t.Run("interquartile range", func(t *testing.T) {
+		// quartiles are 4 and 5
+		assert.Equal(t, 1, IQR(histogram))
+		assert.Equal(t, 2, IQR(histogramNormal))
+	})
+
+	t.Run("modes must return every peak", func(t *testing.T) {
+		assert.Equal(t, []int{2, 4, 7, 9}, Modes(histogram))
+		assert.Equal(t, []int{3}, Modes(histogramNormal))
+		assert.Equal(t, []int{1, 5}, Modes([]int{0, 4, 4, 1, 2, 6}))
+		assert.Nil(t, Modes([]int{0, 0}))
+		assert.Equal(t, []int{0}, Modes([]int{3, 3, 3}))
+	})
+}
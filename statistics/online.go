@@ -0,0 +1,258 @@
+package statistics
+
+import (
+	"math"
+	"slices"
+)
+
+// Accumulator calculates statistics of a stream of samples in a single
+// pass and constant memory, using the online algorithms of Welford and
+// Pébay. Partial accumulators, e.g. from parallel workers, can be merged.
+//
+// # Note
+//
+// The zero value is an empty accumulator ready to use.
+type Accumulator struct {
+	n    int
+	mean float64
+	m2   float64
+	m3   float64
+	m4   float64
+	min  float64
+	max  float64
+}
+
+// NewAccumulator instantiates an empty accumulator.
+func NewAccumulator() Accumulator {
+	return Accumulator{}
+}
+
+// Add adds a sample to the accumulator.
+func (a *Accumulator) Add(x float64) {
+	if a.n == 0 {
+		a.min, a.max = x, x
+	} else {
+		a.min = math.Min(a.min, x)
+		a.max = math.Max(a.max, x)
+	}
+
+	n1 := float64(a.n)
+	a.n++
+	n := float64(a.n)
+
+	delta := x - a.mean
+	deltan := delta / n
+	deltan2 := deltan * deltan
+	term := delta * deltan * n1
+
+	a.mean += deltan
+	a.m4 += term*deltan2*(n*n-3*n+3) + 6*deltan2*a.m2 - 4*deltan*a.m3
+	a.m3 += term*deltan*(n-2) - 3*deltan*a.m2
+	a.m2 += term
+}
+
+// Merge combines the samples of other accumulator into this accumulator, as
+// if all samples were added to it.
+func (a *Accumulator) Merge(other Accumulator) {
+	if other.n == 0 {
+		return
+	}
+	if a.n == 0 {
+		*a = other
+		return
+	}
+
+	na, nb := float64(a.n), float64(other.n)
+	n := na + nb
+	delta := other.mean - a.mean
+	delta2 := delta * delta
+
+	m2 := a.m2 + other.m2 + delta2*na*nb/n
+	m3 := a.m3 + other.m3 + delta2*delta*na*nb*(na-nb)/(n*n) +
+		3*delta*(na*other.m2-nb*a.m2)/n
+	m4 := a.m4 + other.m4 + delta2*delta2*na*nb*(na*na-na*nb+nb*nb)/(n*n*n) +
+		6*delta2*(na*na*other.m2+nb*nb*a.m2)/(n*n) +
+		4*delta*(na*other.m3-nb*a.m3)/n
+
+	a.n += other.n
+	a.mean = (na*a.mean + nb*other.mean) / n
+	a.m2, a.m3, a.m4 = m2, m3, m4
+	a.min = math.Min(a.min, other.min)
+	a.max = math.Max(a.max, other.max)
+}
+
+// Count returns the number of samples.
+func (a Accumulator) Count() int {
+	return a.n
+}
+
+// Mean returns the samples mean.
+func (a Accumulator) Mean() float64 {
+	return a.mean
+}
+
+// Min returns the minimum sample, or zero if the accumulator is empty.
+func (a Accumulator) Min() float64 {
+	return a.min
+}
+
+// Max returns the maximum sample, or zero if the accumulator is empty.
+func (a Accumulator) Max() float64 {
+	return a.max
+}
+
+// PopulationVariance returns the samples variance, dividing by n.
+func (a Accumulator) PopulationVariance() float64 {
+	if a.n == 0 {
+		return 0.0
+	}
+	return a.m2 / float64(a.n)
+}
+
+// SampleVariance returns the unbiased samples variance, dividing by n-1.
+func (a Accumulator) SampleVariance() float64 {
+	if a.n < 2 {
+		return 0.0
+	}
+	return a.m2 / float64(a.n-1)
+}
+
+// PopulationStdDev returns the samples standard deviation, dividing by n.
+func (a Accumulator) PopulationStdDev() float64 {
+	return math.Sqrt(a.PopulationVariance())
+}
+
+// SampleStdDev returns the samples standard deviation, dividing by n-1.
+func (a Accumulator) SampleStdDev() float64 {
+	return math.Sqrt(a.SampleVariance())
+}
+
+// Skewness returns the population skewness, as SampleSkewness.
+func (a Accumulator) Skewness() float64 {
+	if a.m2 == 0 {
+		return 0.0
+	}
+	n := float64(a.n)
+	return math.Sqrt(n) * a.m3 / math.Pow(a.m2, 1.5)
+}
+
+// Kurtosis returns the population excess kurtosis, as SampleKurtosis.
+func (a Accumulator) Kurtosis() float64 {
+	if a.m2 == 0 {
+		return 0.0
+	}
+	n := float64(a.n)
+	return n*a.m4/(a.m2*a.m2) - 3.0
+}
+
+// P2Quantile estimates a quantile of a stream of samples in constant memory,
+// using the P² algorithm of Jain and Chlamtac. Five markers are adjusted
+// with piecewise parabolic interpolation as samples arrive.
+type P2Quantile struct {
+	p       float64
+	count   int
+	heights [5]float64
+	pos     [5]float64
+	desired [5]float64
+	incr    [5]float64
+}
+
+// NewP2Quantile instantiates a streaming estimator of the p quantile,
+// with p in the interval [0, 1].
+func NewP2Quantile(p float64) (P2Quantile, error) {
+	if !(p >= 0.0 && p <= 1.0) {
+		return P2Quantile{}, ErrBadProbability
+	}
+	return P2Quantile{
+		p:       p,
+		pos:     [5]float64{0, 1, 2, 3, 4},
+		desired: [5]float64{0, 2 * p, 4 * p, 2 + 2*p, 4},
+		incr:    [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}, nil
+}
+
+// Count returns the number of samples.
+func (q P2Quantile) Count() int {
+	return q.count
+}
+
+// Add adds a sample to the estimator.
+func (q *P2Quantile) Add(x float64) {
+	if q.count < 5 {
+		q.heights[q.count] = x
+		q.count++
+		if q.count == 5 {
+			slices.Sort(q.heights[:])
+		}
+		return
+	}
+	q.count++
+
+	// finds the cell of the sample, extending the extreme markers
+	var k int
+	switch {
+	case x < q.heights[0]:
+		q.heights[0] = x
+		k = 0
+	case x >= q.heights[4]:
+		q.heights[4] = x
+		k = 3
+	default:
+		for k = 0; k < 3 && x >= q.heights[k+1]; k++ {
+		}
+	}
+
+	for i := k + 1; i < 5; i++ {
+		q.pos[i]++
+	}
+	for i := range q.desired {
+		q.desired[i] += q.incr[i]
+	}
+
+	// adjusts the heights of the middle markers
+	for i := 1; i < 4; i++ {
+		d := q.desired[i] - q.pos[i]
+		if (d >= 1 && q.pos[i+1]-q.pos[i] > 1) || (d <= -1 && q.pos[i-1]-q.pos[i] < -1) {
+			ds := math.Copysign(1, d)
+			h := q.parabolic(i, ds)
+			if q.heights[i-1] < h && h < q.heights[i+1] {
+				q.heights[i] = h
+			} else {
+				j := i + int(ds)
+				q.heights[i] += ds * (q.heights[j] - q.heights[i]) / (q.pos[j] - q.pos[i])
+			}
+			q.pos[i] += ds
+		}
+	}
+}
+
+// parabolic calculates the P² parabolic prediction of the marker i height.
+func (q P2Quantile) parabolic(i int, d float64) float64 {
+	h, n := q.heights, q.pos
+	return h[i] + d/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+d)*(h[i+1]-h[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-d)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+}
+
+// Value returns the estimated quantile. With less than five samples the
+// exact quantile of the samples is returned, or zero for no samples.
+// The quantiles 0 and 1 are the exact minimum and maximum.
+func (q P2Quantile) Value() float64 {
+	if q.count == 0 {
+		return 0.0
+	}
+	if q.count < 5 {
+		sorted := slices.Clone(q.heights[:q.count])
+		slices.Sort(sorted)
+		return quantileSorted(sorted, q.p, Linear)
+	}
+
+	// the outer markers track the extremes exactly
+	switch q.p {
+	case 0.0:
+		return q.heights[0]
+	case 1.0:
+		return q.heights[4]
+	}
+	return q.heights[2]
+}
@@ -0,0 +1,134 @@
+package statistics
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccumulator(t *testing.T) {
+	samples := []float64{2, 4, 4, 4, 5, 5, 7, 9, -3.5, 12.25, 0.5}
+
+	accumulate := func(data []float64) Accumulator {
+		a := NewAccumulator()
+		for _, e := range data {
+			a.Add(e)
+		}
+		return a
+	}
+
+	assertMatchesSamples := func(t *testing.T, a Accumulator, data []float64) {
+		t.Helper()
+		assert.Equal(t, len(data), a.Count())
+		assert.InDelta(t, SampleMean(data), a.Mean(), 0.0001)
+		assert.InDelta(t, PopulationVariance(data), a.PopulationVariance(), 0.0001)
+		assert.InDelta(t, SampleVariance(data), a.SampleVariance(), 0.0001)
+		assert.InDelta(t, PopulationStdDev(data), a.PopulationStdDev(), 0.0001)
+		assert.InDelta(t, SampleStdDev(data), a.SampleStdDev(), 0.0001)
+		assert.InDelta(t, SampleSkewness(data), a.Skewness(), 0.0001)
+		assert.InDelta(t, SampleKurtosis(data), a.Kurtosis(), 0.0001)
+	}
+
+	t.Run("accumulator must match sample statistics", func(t *testing.T) {
+		a := accumulate(samples)
+		assertMatchesSamples(t, a, samples)
+		assert.Equal(t, -3.5, a.Min())
+		assert.Equal(t, 12.25, a.Max())
+	})
+
+	t.Run("empty and constant accumulators", func(t *testing.T) {
+		var a Accumulator
+		assert.Equal(t, 0, a.Count())
+		assert.Equal(t, 0.0, a.PopulationVariance())
+		assert.Equal(t, 0.0, a.SampleVariance())
+		assert.Equal(t, 0.0, a.Skewness())
+
+		a = accumulate([]float64{3, 3, 3})
+		assert.Equal(t, 3.0, a.Mean())
+		assert.Equal(t, 0.0, a.Kurtosis())
+	})
+
+	t.Run("merged accumulators must match a single accumulator", func(t *testing.T) {
+		for split := 0; split <= len(samples); split++ {
+			a := accumulate(samples[:split])
+			a.Merge(accumulate(samples[split:]))
+			assertMatchesSamples(t, a, samples)
+			assert.Equal(t, -3.5, a.Min())
+			assert.Equal(t, 12.25, a.Max())
+		}
+	})
+}
+
+func TestP2Quantile(t *testing.T) {
+	t.Run("probability must be validated", func(t *testing.T) {
+		_, err := NewP2Quantile(1.5)
+		assert.ErrorIs(t, err, ErrBadProbability)
+	})
+
+	t.Run("few samples must return the exact quantile", func(t *testing.T) {
+		q, _ := NewP2Quantile(0.5)
+		assert.Equal(t, 0.0, q.Value())
+		for _, e := range []float64{7, 1, 3} {
+			q.Add(e)
+		}
+		assert.Equal(t, 3, q.Count())
+		assert.Equal(t, 3.0, q.Value())
+	})
+
+	t.Run("estimates must approach the true quantiles", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		data := make([]float64, 20000)
+		for i := range data {
+			data[i] = r.NormFloat64()*10 + 50
+		}
+
+		for _, p := range []float64{0.1, 0.5, 0.9, 0.99} {
+			q, _ := NewP2Quantile(p)
+			for _, e := range data {
+				q.Add(e)
+			}
+			expected, _ := SampleQuantile(data, p, Linear)
+			assert.InDelta(t, expected, q.Value(), 0.5, "p = %f", p)
+		}
+	})
+
+	t.Run("extreme quantiles must be the minimum and maximum", func(t *testing.T) {
+		lower, _ := NewP2Quantile(0)
+		upper, _ := NewP2Quantile(1)
+		r := rand.New(rand.NewSource(3))
+		for _, e := range r.Perm(97) {
+			lower.Add(float64(e))
+			upper.Add(float64(e))
+		}
+		assert.Equal(t, 0.0, lower.Value())
+		assert.Equal(t, 96.0, upper.Value())
+	})
+
+	t.Run("sorted input must be estimated", func(t *testing.T) {
+		q, _ := NewP2Quantile(0.5)
+		for i := 1; i <= 1001; i++ {
+			q.Add(float64(i))
+		}
+		assert.InDelta(t, 501.0, q.Value(), 5.0)
+		assert.False(t, math.IsNaN(q.Value()))
+	})
+}
+
+func BenchmarkAccumulator(b *testing.B) {
+	var a Accumulator
+	q, _ := NewP2Quantile(0.5)
+
+	b.Run("accumulator add must not allocate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.Add(float64(i % 100))
+		}
+	})
+
+	b.Run("p2 add must not allocate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q.Add(float64(i % 100))
+		}
+	})
+}
@@ -0,0 +1,301 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/jgardona/cmath/constraints"
+)
+
+// The functions in this file treat their input as plain samples, i.e. each
+// element is an observed value. Unless noted, they return zero for empty samples.
+
+var (
+	ErrEmptySamples      = errors.New("samples must not be empty")
+	ErrLengthMismatch    = errors.New("samples and weights must have the same length")
+	ErrBadWeights        = errors.New("weights must be non negative with a positive sum")
+	ErrBadProbability    = errors.New("probability must be in the interval [0, 1]")
+	ErrBadProportion     = errors.New("proportion must be in the interval [0, 0.5)")
+	ErrNonPositiveSample = errors.New("samples must be positive")
+	ErrBadQuantileMethod = errors.New("invalid quantile method")
+)
+
+// QuantileMethod is the interpolation used to calculate quantiles when the
+// quantile falls between two samples.
+type QuantileMethod int
+
+const (
+	// Linear interpolates between the closest samples (Hyndman and Fan type 7).
+	Linear QuantileMethod = iota
+	// Lower uses the closest sample below the quantile.
+	Lower
+	// Higher uses the closest sample above the quantile.
+	Higher
+	// Nearest uses the nearest sample, rounding halves to even indexes.
+	Nearest
+	// Midpoint uses the average of the closest samples.
+	Midpoint
+	// Hazen interpolates using the piecewise linear function of Hazen (type 5).
+	Hazen
+	// Weibull interpolates using p(n+1) as position (type 6).
+	Weibull
+	// MedianUnbiased interpolates with approximately median unbiased quantiles (type 8).
+	MedianUnbiased
+)
+
+// sortedFloats returns a sorted float copy of the samples.
+func sortedFloats[T constraints.Numbers](samples []T) []float64 {
+	sorted := make([]float64, len(samples))
+	for i, e := range samples {
+		sorted[i] = float64(e)
+	}
+	slices.Sort(sorted)
+	return sorted
+}
+
+// SampleMean calculates the arithmetic mean of the samples.
+func SampleMean[T constraints.Numbers](samples []T) float64 {
+	if len(samples) == 0 {
+		return 0.0
+	}
+	var sum float64
+	for _, e := range samples {
+		sum += float64(e)
+	}
+	return sum / float64(len(samples))
+}
+
+// WeightedMean calculates the mean of the samples, where each sample
+// contributes proportionally to its weight.
+func WeightedMean[T constraints.Numbers](samples []T, weights []float64) (float64, error) {
+	if len(samples) != len(weights) {
+		return 0.0, ErrLengthMismatch
+	}
+	var sum, total float64
+	for i, e := range samples {
+		if weights[i] < 0 {
+			return 0.0, ErrBadWeights
+		}
+		sum += float64(e) * weights[i]
+		total += weights[i]
+	}
+	if total == 0 {
+		return 0.0, ErrBadWeights
+	}
+	return sum / total, nil
+}
+
+// centralMoment calculates the k-th central moment of the samples.
+func centralMoment[T constraints.Numbers](samples []T, mean float64, k int) float64 {
+	var sum float64
+	for _, e := range samples {
+		d := float64(e) - mean
+		p := 1.0
+		for i := 0; i < k; i++ {
+			p *= d
+		}
+		sum += p
+	}
+	return sum / float64(len(samples))
+}
+
+// PopulationVariance calculates the variance of the samples, dividing by n.
+func PopulationVariance[T constraints.Numbers](samples []T) float64 {
+	if len(samples) == 0 {
+		return 0.0
+	}
+	return centralMoment(samples, SampleMean(samples), 2)
+}
+
+// SampleVariance calculates the unbiased variance of the samples, dividing
+// by n-1. Returns zero for less than two samples.
+func SampleVariance[T constraints.Numbers](samples []T) float64 {
+	n := float64(len(samples))
+	if n < 2 {
+		return 0.0
+	}
+	return PopulationVariance(samples) * n / (n - 1.0)
+}
+
+// PopulationStdDev calculates the standard deviation of the samples, dividing by n.
+func PopulationStdDev[T constraints.Numbers](samples []T) float64 {
+	return math.Sqrt(PopulationVariance(samples))
+}
+
+// SampleStdDev calculates the standard deviation of the samples, dividing by n-1.
+func SampleStdDev[T constraints.Numbers](samples []T) float64 {
+	return math.Sqrt(SampleVariance(samples))
+}
+
+// SampleMedian calculates the median of the samples, averaging the two
+// middle samples for even lengths.
+func SampleMedian[T constraints.Numbers](samples []T) float64 {
+	if len(samples) == 0 {
+		return 0.0
+	}
+	return quantileSorted(sortedFloats(samples), 0.5, Linear)
+}
+
+// SampleQuantile calculates the p quantile of the samples, with p in the
+// interval [0, 1], using the given interpolation method.
+func SampleQuantile[T constraints.Numbers](samples []T, p float64, method QuantileMethod) (float64, error) {
+	if len(samples) == 0 {
+		return 0.0, ErrEmptySamples
+	}
+	if !(p >= 0.0 && p <= 1.0) {
+		return 0.0, ErrBadProbability
+	}
+	if method < Linear || method > MedianUnbiased {
+		return 0.0, ErrBadQuantileMethod
+	}
+	return quantileSorted(sortedFloats(samples), p, method), nil
+}
+
+// quantileSorted calculates a quantile of sorted samples.
+func quantileSorted(sorted []float64, p float64, method QuantileMethod) float64 {
+	n := float64(len(sorted))
+
+	// h is the zero based position of the quantile
+	var h float64
+	switch method {
+	case Hazen:
+		h = n*p - 0.5
+	case Weibull:
+		h = (n+1.0)*p - 1.0
+	case MedianUnbiased:
+		h = (n+1.0/3.0)*p - 2.0/3.0
+	default:
+		h = (n - 1.0) * p
+	}
+	h = math.Max(0.0, math.Min(h, n-1.0))
+
+	lo, hi := int(math.Floor(h)), int(math.Ceil(h))
+	switch method {
+	case Lower:
+		return sorted[lo]
+	case Higher:
+		return sorted[hi]
+	case Nearest:
+		return sorted[int(math.RoundToEven(h))]
+	case Midpoint:
+		return (sorted[lo] + sorted[hi]) / 2.0
+	default:
+		return sorted[lo] + (h-float64(lo))*(sorted[hi]-sorted[lo])
+	}
+}
+
+// SampleModes returns all the most frequent samples in ascending order.
+func SampleModes[T constraints.Numbers](samples []T) []T {
+	counts := make(map[T]int, len(samples))
+	best := 0
+	for _, e := range samples {
+		counts[e]++
+		best = max(best, counts[e])
+	}
+
+	var modes []T
+	for k, v := range counts {
+		if v == best {
+			modes = append(modes, k)
+		}
+	}
+	slices.Sort(modes)
+	return modes
+}
+
+// MAD calculates the median absolute deviation, i.e. the median of the
+// absolute deviations from the samples median.
+func MAD[T constraints.Numbers](samples []T) float64 {
+	median := SampleMedian(samples)
+	deviations := make([]float64, len(samples))
+	for i, e := range samples {
+		deviations[i] = math.Abs(float64(e) - median)
+	}
+	return SampleMedian(deviations)
+}
+
+// SampleIQR calculates the interquartile range of the samples, using
+// linear interpolated quartiles.
+func SampleIQR[T constraints.Numbers](samples []T) float64 {
+	if len(samples) == 0 {
+		return 0.0
+	}
+	sorted := sortedFloats(samples)
+	return quantileSorted(sorted, 0.75, Linear) - quantileSorted(sorted, 0.25, Linear)
+}
+
+// SampleSkewness calculates the population skewness m3/m2^1.5 of the samples,
+// where mk is the k-th central moment. Returns zero if the samples have
+// no dispersion.
+func SampleSkewness[T constraints.Numbers](samples []T) float64 {
+	if len(samples) == 0 {
+		return 0.0
+	}
+	mean := SampleMean(samples)
+	m2 := centralMoment(samples, mean, 2)
+	if m2 == 0 {
+		return 0.0
+	}
+	return centralMoment(samples, mean, 3) / math.Pow(m2, 1.5)
+}
+
+// SampleKurtosis calculates the population excess kurtosis m4/m2² - 3 of the
+// samples, where mk is the k-th central moment. Returns zero if the samples
+// have no dispersion.
+func SampleKurtosis[T constraints.Numbers](samples []T) float64 {
+	if len(samples) == 0 {
+		return 0.0
+	}
+	mean := SampleMean(samples)
+	m2 := centralMoment(samples, mean, 2)
+	if m2 == 0 {
+		return 0.0
+	}
+	return centralMoment(samples, mean, 4)/(m2*m2) - 3.0
+}
+
+// GeometricMean calculates the geometric mean of positive samples.
+func GeometricMean[T constraints.Numbers](samples []T) (float64, error) {
+	if len(samples) == 0 {
+		return 0.0, ErrEmptySamples
+	}
+	var sum float64
+	for _, e := range samples {
+		if e <= 0 {
+			return 0.0, ErrNonPositiveSample
+		}
+		sum += math.Log(float64(e))
+	}
+	return math.Exp(sum / float64(len(samples))), nil
+}
+
+// HarmonicMean calculates the harmonic mean of positive samples.
+func HarmonicMean[T constraints.Numbers](samples []T) (float64, error) {
+	if len(samples) == 0 {
+		return 0.0, ErrEmptySamples
+	}
+	var sum float64
+	for _, e := range samples {
+		if e <= 0 {
+			return 0.0, ErrNonPositiveSample
+		}
+		sum += 1.0 / float64(e)
+	}
+	return float64(len(samples)) / sum, nil
+}
+
+// TrimmedMean calculates the mean after discarding the given proportion of
+// the smallest and of the largest samples. The number of discarded samples
+// on each side is rounded down.
+func TrimmedMean[T constraints.Numbers](samples []T, proportion float64) (float64, error) {
+	if len(samples) == 0 {
+		return 0.0, ErrEmptySamples
+	}
+	if !(proportion >= 0.0 && proportion < 0.5) {
+		return 0.0, ErrBadProportion
+	}
+	sorted := sortedFloats(samples)
+	cut := int(proportion * float64(len(sorted)))
+	return SampleMean(sorted[cut : len(sorted)-cut]), nil
+}
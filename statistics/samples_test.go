@@ -0,0 +1,128 @@
+package statistics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleStatistics(t *testing.T) {
+	samples := []int{2, 4, 4, 4, 5, 5, 7, 9}
+	empty := []float64{}
+
+	t.Run("mean and weighted mean", func(t *testing.T) {
+		assert.InDelta(t, 5.0, SampleMean(samples), 0.0001)
+		assert.Equal(t, 0.0, SampleMean(empty))
+
+		result, err := WeightedMean([]float64{1, 2, 3}, []float64{3, 1, 0})
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.25, result, 0.0001)
+
+		_, err = WeightedMean([]int{1, 2}, []float64{1})
+		assert.ErrorIs(t, err, ErrLengthMismatch)
+		_, err = WeightedMean([]int{1, 2}, []float64{0, 0})
+		assert.ErrorIs(t, err, ErrBadWeights)
+		_, err = WeightedMean([]int{1, 2}, []float64{2, -1})
+		assert.ErrorIs(t, err, ErrBadWeights)
+	})
+
+	t.Run("variance and standard deviation", func(t *testing.T) {
+		assert.InDelta(t, 4.0, PopulationVariance(samples), 0.0001)
+		assert.InDelta(t, 2.0, PopulationStdDev(samples), 0.0001)
+		assert.InDelta(t, 4.5714, SampleVariance(samples), 0.0001)
+		assert.InDelta(t, 2.1381, SampleStdDev(samples), 0.0001)
+		assert.Equal(t, 0.0, SampleVariance([]int{3}))
+		assert.Equal(t, 0.0, PopulationVariance(empty))
+	})
+
+	t.Run("median", func(t *testing.T) {
+		assert.InDelta(t, 4.5, SampleMedian(samples), 0.0001)
+		assert.InDelta(t, 3.0, SampleMedian([]float64{5, 1, 3}), 0.0001)
+		assert.Equal(t, 0.0, SampleMedian(empty))
+	})
+
+	t.Run("quantile methods", func(t *testing.T) {
+		data := []int{4, 1, 3, 2}
+		testCases := []struct {
+			method   QuantileMethod
+			expected float64
+		}{
+			{Linear, 2.2},
+			{Lower, 2.0},
+			{Higher, 3.0},
+			{Nearest, 2.0},
+			{Midpoint, 2.5},
+			{Hazen, 2.1},
+			{Weibull, 2.0},
+			{MedianUnbiased, 2.0667},
+		}
+		for _, tc := range testCases {
+			result, err := SampleQuantile(data, 0.4, tc.method)
+			assert.Nil(t, err)
+			assert.InDelta(t, tc.expected, result, 0.0001, "method %d", tc.method)
+		}
+
+		result, _ := SampleQuantile(data, 1.0, Weibull)
+		assert.Equal(t, 4.0, result)
+		result, _ = SampleQuantile(data, 0.0, Hazen)
+		assert.Equal(t, 1.0, result)
+
+		_, err := SampleQuantile(data, 1.1, Linear)
+		assert.ErrorIs(t, err, ErrBadProbability)
+		_, err = SampleQuantile(empty, 0.5, Linear)
+		assert.ErrorIs(t, err, ErrEmptySamples)
+		_, err = SampleQuantile(data, 0.5, QuantileMethod(42))
+		assert.ErrorIs(t, err, ErrBadQuantileMethod)
+	})
+
+	t.Run("modes", func(t *testing.T) {
+		assert.Equal(t, []int{4}, SampleModes(samples))
+		assert.Equal(t, []float64{1.5, 3}, SampleModes([]float64{3, 1.5, 3, 1.5, 2}))
+		assert.Nil(t, SampleModes(empty))
+	})
+
+	t.Run("mad and iqr", func(t *testing.T) {
+		assert.InDelta(t, 1.0, MAD([]int{1, 1, 2, 2, 4, 6, 9}), 0.0001)
+		assert.InDelta(t, 3.5, SampleIQR([]int{1, 2, 3, 4, 5, 6, 7, 8}), 0.0001)
+		assert.Equal(t, 0.0, SampleIQR(empty))
+	})
+
+	t.Run("skewness and kurtosis", func(t *testing.T) {
+		assert.InDelta(t, 0.65625, SampleSkewness(samples), 0.0001)
+		assert.InDelta(t, -0.21875, SampleKurtosis(samples), 0.0001)
+		assert.InDelta(t, -0.65625, SampleSkewness([]int{-2, -4, -4, -4, -5, -5, -7, -9}), 0.0001)
+		assert.Equal(t, 0.0, SampleSkewness([]int{3, 3}))
+		assert.Equal(t, 0.0, SampleKurtosis([]int{3, 3}))
+	})
+
+	t.Run("geometric and harmonic means", func(t *testing.T) {
+		result, err := GeometricMean([]int{1, 2, 4, 8})
+		assert.Nil(t, err)
+		assert.InDelta(t, 2.8284, result, 0.0001)
+
+		result, err = HarmonicMean([]float64{1, 2, 4})
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.7142, result, 0.0001)
+
+		_, err = GeometricMean([]int{1, 0})
+		assert.ErrorIs(t, err, ErrNonPositiveSample)
+		_, err = HarmonicMean([]int{1, -2})
+		assert.ErrorIs(t, err, ErrNonPositiveSample)
+		_, err = HarmonicMean(empty)
+		assert.ErrorIs(t, err, ErrEmptySamples)
+	})
+
+	t.Run("trimmed mean", func(t *testing.T) {
+		result, err := TrimmedMean([]int{1, 2, 3, 4, 100}, 0.2)
+		assert.Nil(t, err)
+		assert.InDelta(t, 3.0, result, 0.0001)
+
+		result, _ = TrimmedMean([]int{1, 2, 3, 4, 100}, 0.0)
+		assert.InDelta(t, 22.0, result, 0.0001)
+
+		_, err = TrimmedMean([]int{1, 2}, 0.5)
+		assert.ErrorIs(t, err, ErrBadProportion)
+		_, err = TrimmedMean(empty, 0.1)
+		assert.ErrorIs(t, err, ErrEmptySamples)
+	})
+}
@@ -0,0 +1,215 @@
+// # tools
+//
+// This package contains integer and power of 2 math tools.
+package cmath
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/jgardona/cmath/constraints"
+)
+
+var (
+	ErrOverflow      = errors.New("result overflows int")
+	ErrNegativeValue = errors.New("value must not be negative")
+	ErrBadModulus    = errors.New("modulus must be positive")
+)
+
+// IsPowerOf2 checks if the specified integer is a power of 2.
+func IsPowerOf2(x int) bool {
+	return x > 0 && x&(x-1) == 0
+}
+
+// Log2 returns the base of binary logarithm rounded up, i.e. the smallest
+// n where 2^n >= x. Returns 0 for values less than or equal to 1.
+func Log2(x int) int {
+	if x <= 1 {
+		return 0
+	}
+	return bits.Len(uint(x - 1))
+}
+
+// NextPowerOf2 returns the smallest power of 2 greater than or equal to x.
+// Returns 1 for values less than or equal to 1, and 0 if the power of 2
+// doesn't fit in an int.
+func NextPowerOf2(x int) int {
+	n := Log2(x)
+	if n >= bits.UintSize-1 {
+		return 0
+	}
+	return 1 << n
+}
+
+// ISqrt calculates the integer square root, i.e. the largest n where n*n <= x.
+func ISqrt(x int) (int, error) {
+	if x < 0 {
+		return 0, ErrNegativeValue
+	}
+	if x < 2 {
+		return x, nil
+	}
+
+	// the float estimation is refined to fix rounding errors of large values
+	r := int(math.Sqrt(float64(x)))
+	for r > 0 && r > x/r {
+		r--
+	}
+	for r+1 <= x/(r+1) {
+		r++
+	}
+	return r, nil
+}
+
+// GCD calculates the greatest common divisor of two integers.
+// The result is non negative, and GCD(0, 0) is 0.
+//
+// # Note
+//
+// The only exception is a greatest common divisor of 2^63, which doesn't fit
+// in an int: GCD(math.MinInt, 0), GCD(0, math.MinInt) and
+// GCD(math.MinInt, math.MinInt) return math.MinInt.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// LCM calculates the least common multiple of two integers.
+// The result is always non negative, and is 0 if a or b is 0.
+func LCM(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	// any multiple of math.MinInt is too large, and -math.MinInt overflows
+	if a == math.MinInt || b == math.MinInt {
+		return 0, ErrOverflow
+	}
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	return mulInt(a/GCD(a, b), b)
+}
+
+// ModPow calculates (base^exp) mod m without overflowing intermediate values.
+// The result is in the interval [0, m).
+func ModPow(base, exp, m int) (int, error) {
+	if exp < 0 {
+		return 0, ErrNegativeValue
+	}
+	if m <= 0 {
+		return 0, ErrBadModulus
+	}
+
+	mod := uint64(m)
+	b := base % m
+	if b < 0 {
+		b += m
+	}
+	ub, result := uint64(b), uint64(1)%mod
+
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = mulMod(result, ub, mod)
+		}
+		ub = mulMod(ub, ub, mod)
+	}
+	return int(result), nil
+}
+
+// mulMod calculates (a*b) mod m using 128 bits intermediate values.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// mulInt multiplies two non negative integers, detecting overflows.
+func mulInt(a, b int) (int, error) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi != 0 || lo > math.MaxInt {
+		return 0, ErrOverflow
+	}
+	return int(lo), nil
+}
+
+// Binomial calculates the binomial coefficient C(n, k), i.e. the number of
+// ways to choose k elements from n. Returns 0 if k is outside [0, n].
+func Binomial(n, k int) (int, error) {
+	if n < 0 {
+		return 0, ErrNegativeValue
+	}
+	if k < 0 || k > n {
+		return 0, nil
+	}
+	if k > n-k {
+		k = n - k
+	}
+
+	var err error
+	result := 1
+	for i := 0; i < k; i++ {
+		// result*(n-i) is divisible by (i+1), dividing by gcd first
+		// keeps the intermediate values as small as the final one
+		g := GCD(result, i+1)
+		result, err = mulInt(result/g, (n-i)/((i+1)/g))
+		if err != nil {
+			return 0, err
+		}
+	}
+	return result, nil
+}
+
+// Factorial calculates n!, returning ErrOverflow if the result doesn't fit in an int.
+func Factorial(n int) (int, error) {
+	if n < 0 {
+		return 0, ErrNegativeValue
+	}
+
+	var err error
+	result := 1
+	for i := 2; i <= n; i++ {
+		result, err = mulInt(result, i)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return result, nil
+}
+
+// Pow calculates base^exp using exponentiation by squaring.
+//
+// # Note
+//
+// Negative exponents return 1/base^-exp, which is truncated for integers.
+// A zero base with a negative exponent returns +Inf for floats, as in
+// math.Pow, and 0 for integers. Integer overflows are not detected, as in Prod.
+func Pow[T constraints.Numbers](base T, exp int) T {
+	e := uint(exp)
+	if exp < 0 {
+		e = uint(-exp)
+	}
+
+	var result T = 1
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result *= base
+		}
+		base *= base
+	}
+
+	if exp < 0 {
+		if _, ok := any(result).(int); ok && result == 0 {
+			return 0
+		}
+		return 1 / result
+	}
+	return result
+}
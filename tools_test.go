@@ -0,0 +1,279 @@
+package cmath
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowerOf2Tools(t *testing.T) {
+	t.Run("test ispowerof2 function", func(t *testing.T) {
+		for _, x := range []int{1, 2, 4, 1024, 1 << 62} {
+			assert.True(t, IsPowerOf2(x), x)
+		}
+		for _, x := range []int{-4, 0, 3, 6, 1023} {
+			assert.False(t, IsPowerOf2(x), x)
+		}
+	})
+
+	t.Run("test log2 function", func(t *testing.T) {
+		assert.Equal(t, 0, Log2(-1))
+		assert.Equal(t, 0, Log2(1))
+		assert.Equal(t, 1, Log2(2))
+		assert.Equal(t, 2, Log2(3))
+		assert.Equal(t, 10, Log2(1024))
+		assert.Equal(t, 11, Log2(1025))
+	})
+
+	t.Run("test nextpowerof2 function", func(t *testing.T) {
+		assert.Equal(t, 1, NextPowerOf2(0))
+		assert.Equal(t, 1, NextPowerOf2(1))
+		assert.Equal(t, 8, NextPowerOf2(5))
+		assert.Equal(t, 1024, NextPowerOf2(1024))
+		assert.Equal(t, 1<<62, NextPowerOf2(1<<62))
+		assert.Equal(t, 0, NextPowerOf2(1<<62+1))
+	})
+}
+
+func TestIntegerTools(t *testing.T) {
+	t.Run("test isqrt function", func(t *testing.T) {
+		result, err := ISqrt(15)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, result)
+		result, _ = ISqrt(16)
+		assert.Equal(t, 4, result)
+		result, _ = ISqrt(math.MaxInt)
+		assert.Equal(t, 3037000499, result)
+
+		_, err = ISqrt(-1)
+		assert.ErrorIs(t, err, ErrNegativeValue)
+	})
+
+	t.Run("test gcd and lcm functions", func(t *testing.T) {
+		assert.Equal(t, 6, GCD(12, 18))
+		assert.Equal(t, 6, GCD(-12, 18))
+		assert.Equal(t, 5, GCD(0, -5))
+		assert.Equal(t, 0, GCD(0, 0))
+
+		result, err := LCM(4, -6)
+		assert.Nil(t, err)
+		assert.Equal(t, 12, result)
+		result, _ = LCM(0, 6)
+		assert.Equal(t, 0, result)
+
+		_, err = LCM(math.MaxInt, math.MaxInt-1)
+		assert.ErrorIs(t, err, ErrOverflow)
+	})
+
+	t.Run("test modpow function", func(t *testing.T) {
+		result, err := ModPow(4, 13, 497)
+		assert.Nil(t, err)
+		assert.Equal(t, 445, result)
+		result, _ = ModPow(-2, 3, 5)
+		assert.Equal(t, 2, result)
+		result, _ = ModPow(3, 0, 1)
+		assert.Equal(t, 0, result)
+
+		_, err = ModPow(2, -1, 5)
+		assert.ErrorIs(t, err, ErrNegativeValue)
+		_, err = ModPow(2, 3, 0)
+		assert.ErrorIs(t, err, ErrBadModulus)
+	})
+
+	t.Run("test binomial function", func(t *testing.T) {
+		result, err := Binomial(5, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, 10, result)
+		result, _ = Binomial(5, 6)
+		assert.Equal(t, 0, result)
+		result, _ = Binomial(66, 33)
+		assert.Equal(t, 7219428434016265740, result)
+
+		_, err = Binomial(68, 34)
+		assert.ErrorIs(t, err, ErrOverflow)
+		_, err = Binomial(-1, 0)
+		assert.ErrorIs(t, err, ErrNegativeValue)
+	})
+
+	t.Run("test factorial function", func(t *testing.T) {
+		result, err := Factorial(0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, result)
+		result, _ = Factorial(20)
+		assert.Equal(t, 2432902008176640000, result)
+
+		_, err = Factorial(21)
+		assert.ErrorIs(t, err, ErrOverflow)
+		_, err = Factorial(-1)
+		assert.ErrorIs(t, err, ErrNegativeValue)
+	})
+
+	t.Run("test pow function", func(t *testing.T) {
+		assert.Equal(t, 1024, Pow(2, 10))
+		assert.Equal(t, 1, Pow(7, 0))
+		assert.Equal(t, 0, Pow(2, -1))
+		assert.InDelta(t, 0.125, Pow(2.0, -3), 0.0001)
+		assert.InDelta(t, 2.25, Pow(1.5, 2), 0.0001)
+		assert.Equal(t, 0.0, Pow(2.0, math.MinInt))
+
+		// zero bases with negative exponents
+		assert.Equal(t, 0, Pow(0, -1))
+		assert.True(t, math.IsInf(Pow(0.0, -2), 1))
+	})
+}
+
+func FuzzPowersOf2(f *testing.F) {
+	f.Add(0)
+	f.Add(64)
+	f.Add(65)
+	f.Add(math.MaxInt)
+	f.Add(math.MinInt)
+	f.Fuzz(func(t *testing.T, x int) {
+		bx := big.NewInt(int64(x))
+		one := big.NewInt(1)
+
+		// x is a power of 2 when it is positive and has a single bit set
+		isPower := x > 0 && new(big.Int).Lsh(one, uint(bx.BitLen()-1)).Cmp(bx) == 0
+		assert.Equal(t, isPower, IsPowerOf2(x))
+
+		n := Log2(x)
+		power := new(big.Int).Lsh(one, uint(n))
+		if x <= 1 {
+			assert.Equal(t, 0, n)
+		} else {
+			// n is the smallest value where 2^n >= x
+			assert.True(t, power.Cmp(bx) >= 0)
+			assert.True(t, new(big.Int).Rsh(power, 1).Cmp(bx) < 0)
+		}
+
+		if power.IsInt64() && power.Int64() <= math.MaxInt {
+			assert.Equal(t, power.Int64(), int64(NextPowerOf2(x)))
+		} else {
+			assert.Equal(t, 0, NextPowerOf2(x))
+		}
+	})
+}
+
+func FuzzISqrt(f *testing.F) {
+	f.Add(0)
+	f.Add(99)
+	f.Add(math.MaxInt)
+	f.Fuzz(func(t *testing.T, x int) {
+		result, err := ISqrt(x)
+		if x < 0 {
+			assert.ErrorIs(t, err, ErrNegativeValue)
+			return
+		}
+		expected := new(big.Int).Sqrt(big.NewInt(int64(x)))
+		assert.Equal(t, expected.Int64(), int64(result))
+	})
+}
+
+func FuzzGCDAndLCM(f *testing.F) {
+	f.Add(12, 18)
+	f.Add(-7, 0)
+	f.Add(math.MaxInt, 3)
+	f.Add(math.MinInt, 0)
+	f.Add(math.MinInt, 6)
+	f.Fuzz(func(t *testing.T, a, b int) {
+		ba, bb := big.NewInt(int64(a)), big.NewInt(int64(b))
+		gcd := new(big.Int).GCD(nil, nil, new(big.Int).Abs(ba), new(big.Int).Abs(bb))
+		if gcd.IsInt64() {
+			assert.Equal(t, gcd.Int64(), int64(GCD(a, b)))
+		} else {
+			// 2^63 doesn't fit in an int
+			assert.Equal(t, math.MinInt, GCD(a, b))
+		}
+
+		result, err := LCM(a, b)
+		if a == 0 || b == 0 {
+			assert.Equal(t, 0, result)
+			return
+		}
+		lcm := new(big.Int).Abs(new(big.Int).Mul(ba, bb))
+		lcm.Div(lcm, gcd)
+		if lcm.IsInt64() {
+			assert.Nil(t, err)
+			assert.Equal(t, lcm.Int64(), int64(result))
+		} else {
+			assert.ErrorIs(t, err, ErrOverflow)
+		}
+	})
+}
+
+func FuzzModPow(f *testing.F) {
+	f.Add(4, 13, 497)
+	f.Add(-3, 7, 11)
+	f.Add(math.MaxInt, math.MaxInt, math.MaxInt-1)
+	f.Fuzz(func(t *testing.T, base, exp, m int) {
+		result, err := ModPow(base, exp, m)
+		if exp < 0 || m <= 0 {
+			assert.NotNil(t, err)
+			return
+		}
+		bm := big.NewInt(int64(m))
+		expected := new(big.Int).Exp(new(big.Int).Mod(big.NewInt(int64(base)), bm), big.NewInt(int64(exp)), bm)
+		assert.Nil(t, err)
+		assert.Equal(t, expected.Int64(), int64(result))
+	})
+}
+
+func FuzzBinomial(f *testing.F) {
+	f.Add(5, 2)
+	f.Add(66, 33)
+	f.Add(1000, 4)
+	f.Fuzz(func(t *testing.T, n, k int) {
+		if n < 0 || n > 10000 {
+			t.Skip()
+		}
+		result, err := Binomial(n, k)
+		if k < 0 || k > n {
+			assert.Equal(t, 0, result)
+			return
+		}
+		expected := new(big.Int).Binomial(int64(n), int64(k))
+		if expected.IsInt64() {
+			assert.Nil(t, err)
+			assert.Equal(t, expected.Int64(), int64(result))
+		} else {
+			assert.ErrorIs(t, err, ErrOverflow)
+		}
+	})
+}
+
+func FuzzFactorial(f *testing.F) {
+	f.Add(0)
+	f.Add(20)
+	f.Add(21)
+	f.Fuzz(func(t *testing.T, n int) {
+		if n < 0 || n > 1000 {
+			t.Skip()
+		}
+		result, err := Factorial(n)
+		expected := new(big.Int).MulRange(1, int64(n))
+		if expected.IsInt64() {
+			assert.Nil(t, err)
+			assert.Equal(t, expected.Int64(), int64(result))
+		} else {
+			assert.ErrorIs(t, err, ErrOverflow)
+		}
+	})
+}
+
+func FuzzPow(f *testing.F) {
+	f.Add(2, 10)
+	f.Add(-3, 5)
+	f.Add(7, 0)
+	f.Fuzz(func(t *testing.T, base, exp int) {
+		if exp < 0 || exp > 256 {
+			t.Skip()
+		}
+		expected := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(exp)), nil)
+		if !expected.IsInt64() {
+			t.Skip()
+		}
+		assert.Equal(t, expected.Int64(), int64(Pow(base, exp)))
+	})
+}
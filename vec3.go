@@ -214,8 +214,9 @@ func Dot(a, b Vec3) float64 {
 }
 
 // AsVec4 returns a vec4 representation of this vector.
+// The vector is converted to homogeneous coordinates, with w equal to 1.
 func (v Vec3) AsVec4() Vec4 {
-	panic("not yet implemented")
+	return NewVec4(v.x, v.y, v.z, 1.0)
 }
 
 // X returns the vector's x coordinate.
@@ -166,4 +166,10 @@ func TestVec3(t *testing.T) {
 		result := Dot(v1, v2)
 		assert.InDelta(t, 10, result, 0.001)
 	})
+
+	t.Run("test asvec4 method", func(t *testing.T) {
+		result := v1.AsVec4()
+		assert.Equal(t, NewVec4(1, 2, 3, 1), result)
+		assert.Equal(t, v1, result.AsVec3())
+	})
 }
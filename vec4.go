@@ -1,7 +1,16 @@
+// # vec4
+//
+// This package contains a 4D vector structure with x, y, z, w coordinates.
 package cmath
 
 import "math"
 
+// # Vec4
+//
+// A 4D data structure with x, y, z and w coordinates.
+// The structure encapsulates x, y, z and w coordinates of a 4d vector and
+// provides some linear algebra operations. It is mostly used to represent
+// 3D points in homogeneous coordinates.
 type Vec4 struct {
 	x float64
 	y float64
@@ -9,34 +18,229 @@ type Vec4 struct {
 	w float64
 }
 
+// NewVec4 initializes a Vec4 structure.
 func NewVec4(x, y, z, w float64) Vec4 {
 	return Vec4{x, y, z, w}
 }
 
-func (v Vec4) X() float64 {
-	return v.x
+// Max returns the maximum value of the vector.
+func (v Vec4) Max() float64 {
+	a := math.Max(v.x, v.y)
+	b := math.Max(v.z, v.w)
+	return math.Max(a, b)
 }
 
+// Min returns the minimum value of the vector.
 func (v Vec4) Min() float64 {
 	a := math.Min(v.x, v.y)
 	b := math.Min(v.z, v.w)
 	return math.Min(a, b)
 }
 
-func (v Vec4) Max() float64 {
-	a := math.Max(v.x, v.y)
-	b := math.Max(v.z, v.w)
-	return math.Max(a, b)
+// MaxIndex returns the vector's index which has the maximum value.
+func (v Vec4) MaxIndex() int {
+	index := 0
+	values := v.AsArray()
+	for i := 1; i < len(values); i++ {
+		if values[i] > values[index] {
+			index = i
+		}
+	}
+	return index
+}
+
+// MinIndex returns the vector's index which has the minimum value.
+func (v Vec4) MinIndex() int {
+	index := 0
+	values := v.AsArray()
+	for i := 1; i < len(values); i++ {
+		if values[i] < values[index] {
+			index = i
+		}
+	}
+	return index
+}
+
+// Norm returns the norm of a vector.
+func (v Vec4) Norm() float64 {
+	return math.Sqrt(v.x*v.x + v.y*v.y + v.z*v.z + v.w*v.w)
+}
+
+// Dot return the dot product of vector himself.
+func (v Vec4) Dot() float64 {
+	return v.x*v.x + v.y*v.y + v.z*v.z + v.w*v.w
+}
+
+// AsArray returns a vector representation as array
+func (v Vec4) AsArray() [4]float64 {
+	return [4]float64{v.x, v.y, v.z, v.w}
+}
+
+// Sum implements vector addition.
+func (v Vec4) Sum(vec Vec4) Vec4 {
+	sx := v.x + vec.x
+	sy := v.y + vec.y
+	sz := v.z + vec.z
+	sw := v.w + vec.w
+
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// SumScalar adds a scalar.
+func (v Vec4) SumScalar(scalar float64) Vec4 {
+	sx := v.x + scalar
+	sy := v.y + scalar
+	sz := v.z + scalar
+	sw := v.w + scalar
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// Subtract implements vector subtraction.
+func (v Vec4) Subtract(vec Vec4) Vec4 {
+	sx := v.x - vec.x
+	sy := v.y - vec.y
+	sz := v.z - vec.z
+	sw := v.w - vec.w
+
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// SubScalar subtracts a scalar.
+func (v Vec4) SubScalar(scalar float64) Vec4 {
+	sx := v.x - scalar
+	sy := v.y - scalar
+	sz := v.z - scalar
+	sw := v.w - scalar
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// Multiply implements vector multiplication.
+func (v Vec4) Multiply(vec Vec4) Vec4 {
+	sx := v.x * vec.x
+	sy := v.y * vec.y
+	sz := v.z * vec.z
+	sw := v.w * vec.w
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// MulScalar implements scalar vector multiplication.
+func (v Vec4) MulScalar(scalar float64) Vec4 {
+	sx := v.x * scalar
+	sy := v.y * scalar
+	sz := v.z * scalar
+	sw := v.w * scalar
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// Divide implements vector division.
+func (v Vec4) Divide(vec Vec4) Vec4 {
+	if vec.x == 0.0 || vec.y == 0.0 || vec.z == 0.0 || vec.w == 0.0 {
+		panic("cant divide by zero")
+	}
+	sx := v.x / vec.x
+	sy := v.y / vec.y
+	sz := v.z / vec.z
+	sw := v.w / vec.w
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// DivScalar implements vector scalar division.
+func (v Vec4) DivScalar(scalar float64) Vec4 {
+	if scalar == 0.0 {
+		panic("cant divide by zero")
+	}
+	sx := v.x / scalar
+	sy := v.y / scalar
+	sz := v.z / scalar
+	sw := v.w / scalar
+	return NewVec4(sx, sy, sz, sw)
+}
+
+// Equals compares float numbers using epsilon.
+func (v Vec4) Equals(vec Vec4, epsilon float64) bool {
+	dx := math.Abs(v.x-vec.x) < epsilon
+	dy := math.Abs(v.y-vec.y) < epsilon
+	dz := math.Abs(v.z-vec.z) < epsilon
+	dw := math.Abs(v.w-vec.w) < epsilon
+	return dx && dy && dz && dw
+}
+
+// Normalize normalizes this vector by dividing itś coordinates with the vector's norm.
+// Returns the value of vector's norm before normalization.
+func (v *Vec4) Normalize() float64 {
+	norm := v.Norm()
+	invn := 1.0 / norm
+	v.x *= invn
+	v.y *= invn
+	v.z *= invn
+	v.w *= invn
+	return norm
+}
+
+// Inverse inverts the vector.
+// Returns a vector with all coordinate equal to 1.0 divided by the value of correspondent coordinate
+// in this vector (or equal to 0.0 if this vector has corresponding coordinate also set to 0.0).
+func (v Vec4) Inverse() Vec4 {
+	var nx, ny, nz, nw float64 = 0.0, 0.0, 0.0, 0.0
+	if v.x != 0.0 {
+		nx = 1.0 / v.x
+	}
+	if v.y != 0.0 {
+		ny = 1.0 / v.y
+	}
+	if v.z != 0.0 {
+		nz = 1.0 / v.z
+	}
+	if v.w != 0.0 {
+		nw = 1.0 / v.w
+	}
+	return NewVec4(nx, ny, nz, nw)
+}
+
+// Abs calculates the vector's obsolute value.
+// Returns a vector with all coordinates equal to absolute values of this vector's coordinates.
+func (v Vec4) Abs() Vec4 {
+	ax := math.Abs(v.x)
+	ay := math.Abs(v.y)
+	az := math.Abs(v.z)
+	aw := math.Abs(v.w)
+	return NewVec4(ax, ay, az, aw)
+}
+
+// Dot4 Calculates the dot product of two 4D vectors.
+func Dot4(a, b Vec4) float64 {
+	dot := a.x*b.x + a.y*b.y + a.z*b.z + a.w*b.w
+	return dot
+}
+
+// AsVec3 returns a vec3 representation of this vector.
+//
+// The homogeneous coordinates are converted using perspective divide, i.e.
+// x, y and z are divided by w. If w is zero the vector represents a direction
+// and its x, y and z coordinates are returned unchanged.
+func (v Vec4) AsVec3() Vec3 {
+	if v.w == 0.0 || v.w == 1.0 {
+		return NewVec3(v.x, v.y, v.z)
+	}
+	return NewVec3(v.x/v.w, v.y/v.w, v.z/v.w)
+}
+
+// X returns the vector's x coordinate.
+func (v Vec4) X() float64 {
+	return v.x
 }
 
+// Y returns the vector's y coordinate.
 func (v Vec4) Y() float64 {
 	return v.y
 }
 
+// Z returns the vector's z coordinate.
 func (v Vec4) Z() float64 {
 	return v.z
 }
 
+// W returns the vector's w coordinate.
 func (v Vec4) W() float64 {
 	return v.w
 }
@@ -9,11 +9,13 @@ import (
 func TestVec4(t *testing.T) {
 	v1 := NewVec4(1, 2, 3, 4)
 	v2 := NewVec4(4, 3, 2, 1)
-	//v3 := NewVec4(3, 4, 2, 1)
-	//v4 := NewVec4(2, 1, 3, 4)
+	v3 := NewVec4(3, 4, 2, 1)
+	v4 := NewVec4(2, 1, 3, 4)
+	v5 := NewVec4(0, 0, 0, 0)
 
 	t.Run("test dot method", func(t *testing.T) {
-
+		result := v1.Dot()
+		assert.Equal(t, 30.0, result)
 	})
 
 	t.Run("test min method", func(t *testing.T) {
@@ -25,4 +27,119 @@ func TestVec4(t *testing.T) {
 		result := v2.Max()
 		assert.Equal(t, 4.0, result)
 	})
+
+	t.Run("test maxindex method", func(t *testing.T) {
+		assert.Equal(t, 3, v1.MaxIndex())
+		assert.Equal(t, 0, v2.MaxIndex())
+		assert.Equal(t, 1, v3.MaxIndex())
+		assert.Equal(t, 3, v4.MaxIndex())
+	})
+
+	t.Run("test minindex method", func(t *testing.T) {
+		assert.Equal(t, 0, v1.MinIndex())
+		assert.Equal(t, 3, v2.MinIndex())
+		assert.Equal(t, 3, v3.MinIndex())
+		assert.Equal(t, 1, v4.MinIndex())
+	})
+
+	t.Run("test norm method", func(t *testing.T) {
+		result := v1.Norm()
+		assert.InDelta(t, 5.477, result, 0.001)
+	})
+
+	t.Run("test asarray method", func(t *testing.T) {
+		result := v3.AsArray()
+		assert.Equal(t, [4]float64{3, 4, 2, 1}, result)
+	})
+
+	t.Run("test sum method", func(t *testing.T) {
+		result := v4.Sum(v1)
+		assert.Equal(t, NewVec4(3, 3, 6, 8), result)
+	})
+
+	t.Run("test sum scalar method", func(t *testing.T) {
+		result := v1.SumScalar(2.0)
+		assert.Equal(t, NewVec4(3, 4, 5, 6), result)
+	})
+
+	t.Run("test subtract method", func(t *testing.T) {
+		result := v1.Subtract(v2)
+		assert.Equal(t, NewVec4(-3, -1, 1, 3), result)
+	})
+
+	t.Run("test subscalar method", func(t *testing.T) {
+		result := v1.SubScalar(1)
+		assert.Equal(t, NewVec4(0, 1, 2, 3), result)
+	})
+
+	t.Run("test multiply method", func(t *testing.T) {
+		result := v2.Multiply(v3)
+		assert.Equal(t, NewVec4(12, 12, 4, 1), result)
+	})
+
+	t.Run("test mulscalar method", func(t *testing.T) {
+		result := v2.MulScalar(2)
+		assert.Equal(t, NewVec4(8, 6, 4, 2), result)
+	})
+
+	t.Run("test divide method", func(t *testing.T) {
+		result := v2.Divide(v1)
+		assert.True(t, result.Equals(NewVec4(4, 1.5, 0.666, 0.25), 0.001))
+
+		// fail dividing by zero
+		assert.Panics(t, func() {
+			_ = v1.Divide(v5)
+		})
+	})
+
+	t.Run("test divide scalar method", func(t *testing.T) {
+		result := v2.DivScalar(2)
+		assert.Equal(t, NewVec4(2, 1.5, 1, 0.5), result)
+
+		// fail dividing by zero
+		assert.Panics(t, func() {
+			_ = v1.DivScalar(0.0)
+		})
+	})
+
+	t.Run("test equals method", func(t *testing.T) {
+		result := v2.DivScalar(3)
+		vec := NewVec4(1.333, 1.0, 0.666, 0.333)
+		assert.True(t, result.Equals(vec, 0.001))
+		assert.False(t, v1.Equals(v2, 0.001))
+	})
+
+	t.Run("test normalization method", func(t *testing.T) {
+		nv := v1
+		result := nv.Normalize()
+		assert.InDelta(t, 5.477, result, 0.001)
+		assert.InDelta(t, 1.0, nv.Norm(), 0.001)
+		assert.InDelta(t, 0.182, nv.X(), 0.001)
+		assert.InDelta(t, 0.730, nv.W(), 0.001)
+	})
+
+	t.Run("test inverse method", func(t *testing.T) {
+		result := NewVec4(2, 0, 4, 1).Inverse()
+		assert.Equal(t, NewVec4(0.5, 0, 0.25, 1), result)
+	})
+
+	t.Run("test abs method", func(t *testing.T) {
+		fv := NewVec4(-1.5, -3.7, 0.5, -2)
+		result := fv.Abs()
+		assert.Equal(t, NewVec4(1.5, 3.7, 0.5, 2), result)
+	})
+
+	t.Run("test dot4 function", func(t *testing.T) {
+		result := Dot4(v1, v2)
+		assert.InDelta(t, 20, result, 0.001)
+	})
+
+	t.Run("test asvec3 method", func(t *testing.T) {
+		result := NewVec4(2, 4, 6, 2).AsVec3()
+		assert.Equal(t, NewVec3(1, 2, 3), result)
+
+		// directions are not divided
+		result = NewVec4(2, 4, 6, 0).AsVec3()
+		assert.Equal(t, NewVec3(2, 4, 6), result)
+	})
 }